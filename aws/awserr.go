@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"log"
 	"strings"
 	"time"
 
@@ -68,3 +69,111 @@ func retryOnAwsCodes(codes []string, f func() (interface{}, error)) (interface{}
 	})
 	return resp, err
 }
+
+// defaultIamPropagationTimeout is how long retryOnIamPropagation retries when
+// the iam_propagation_timeout provider setting is not available.
+const defaultIamPropagationTimeout = 2 * time.Minute
+
+// awsErrSignature identifies an AWS error by its code and a substring of its
+// message.
+type awsErrSignature struct {
+	Code    string
+	Message string
+}
+
+// iamPropagationErrSignatures catalogs, per service, the errors returned
+// while a newly created or updated IAM role, instance profile, user or policy
+// is not yet visible to that service. IAM is eventually consistent, so these
+// can take several seconds to clear.
+var iamPropagationErrSignatures = map[string][]awsErrSignature{
+	"application-autoscaling": {
+		{"ValidationException", "Unable to assume IAM role"},
+	},
+	"autoscaling": {
+		{"ValidationError", "Invalid IamInstanceProfile"},
+		{"ValidationError", "You are not authorized to perform this operation"},
+	},
+	"codebuild": {
+		{"InvalidInputException", "CodeBuild is not authorized to perform"},
+		{"InvalidInputException", "Not authorized to perform DescribeSecurityGroups"},
+	},
+	"dax": {
+		{"InvalidParameterValueException", "No permission to assume role"},
+	},
+	"ecr": {
+		{"InvalidParameterException", "Invalid repository policy provided"},
+	},
+	"ecs": {
+		{"InvalidParameterException", "Please verify that the ECS service role being passed has the proper permissions."},
+	},
+	"events": {
+		{"ValidationException", "cannot be assumed by principal"},
+	},
+	"firehose": {
+		{"InvalidArgumentException", "is not authorized to"},
+		{"InvalidArgumentException", "Firehose is unable to assume role"},
+	},
+	"iam": {
+		{"MalformedPolicyDocument", "Invalid principal in policy"},
+	},
+	"lambda": {
+		{"InvalidParameterValueException", "cannot be assumed by Lambda"},
+		{"InvalidParameterValueException", "The provided execution role does not have permissions"},
+		{"InvalidParameterValueException", "Please ensure the role can perform"},
+	},
+	"rds": {
+		{"InvalidParameterValue", "IAM role ARN value is invalid or does not include the required permissions"},
+	},
+}
+
+// isIamPropagationErr reports whether err is one of the errors service
+// returns while IAM changes are still propagating.
+func isIamPropagationErr(service string, err error) bool {
+	for _, s := range iamPropagationErrSignatures[service] {
+		if isAWSErr(err, s.Code, s.Message) {
+			return true
+		}
+	}
+	return false
+}
+
+// iamPropagationTimeout returns the configured time to wait for IAM changes
+// to propagate.
+func (c *AWSClient) iamPropagationTimeout() time.Duration {
+	if c.iamPropagationTimeoutValue > 0 {
+		return c.iamPropagationTimeoutValue
+	}
+	return defaultIamPropagationTimeout
+}
+
+// retryOnIamPropagation calls f until it no longer fails with one of the IAM
+// propagation errors of service, or until the configured
+// iam_propagation_timeout has elapsed.
+func retryOnIamPropagation(meta interface{}, service string, f func() (interface{}, error)) (interface{}, error) {
+	return retryOnIamPropagationWithMinimum(meta, service, 0, f)
+}
+
+// retryOnIamPropagationWithMinimum is retryOnIamPropagation for calls known to
+// wait longer on IAM, retrying for at least minimum even when the configured
+// iam_propagation_timeout is shorter.
+func retryOnIamPropagationWithMinimum(meta interface{}, service string, minimum time.Duration, f func() (interface{}, error)) (interface{}, error) {
+	timeout := meta.(*AWSClient).iamPropagationTimeout()
+	if timeout < minimum {
+		timeout = minimum
+	}
+
+	var resp interface{}
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		var err error
+		resp, err = f()
+		if err != nil {
+			if isIamPropagationErr(service, err) {
+				log.Printf("[DEBUG] Waiting for IAM changes to propagate to %s: %s", service, err)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	return resp, err
+}
@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+func TestIsIamPropagationErr(t *testing.T) {
+	cases := []struct {
+		Service  string
+		Err      error
+		Expected bool
+	}{
+		{
+			Service:  "lambda",
+			Err:      awserr.New("InvalidParameterValueException", "The role defined for the function cannot be assumed by Lambda.", nil),
+			Expected: true,
+		},
+		{
+			Service:  "lambda",
+			Err:      awserr.New("InvalidParameterValueException", "Unzipped size must be smaller than 262144000 bytes", nil),
+			Expected: false,
+		},
+		{
+			Service:  "ecs",
+			Err:      awserr.New("InvalidParameterValueException", "The role defined for the function cannot be assumed by Lambda.", nil),
+			Expected: false,
+		},
+		{
+			Service:  "events",
+			Err:      awserr.New("ValidationException", "Provided role 'arn:aws:iam::123456789012:role/r' cannot be assumed by principal 'events.amazonaws.com'.", nil),
+			Expected: true,
+		},
+		{
+			Service:  "application-autoscaling",
+			Err:      awserr.New("ValidationException", "Unable to assume IAM role: arn:aws:iam::123456789012:role/r", nil),
+			Expected: true,
+		},
+		{
+			Service:  "rds",
+			Err:      errors.New("IAM role ARN value is invalid or does not include the required permissions"),
+			Expected: false,
+		},
+		{
+			Service:  "unknown",
+			Err:      awserr.New("InvalidParameterValue", "IAM role ARN value is invalid or does not include the required permissions", nil),
+			Expected: false,
+		},
+	}
+
+	for i, tc := range cases {
+		if actual := isIamPropagationErr(tc.Service, tc.Err); actual != tc.Expected {
+			t.Errorf("%d: expected %t, got %t", i, tc.Expected, actual)
+		}
+	}
+}
+
+func TestRetryOnIamPropagation(t *testing.T) {
+	meta := &AWSClient{iamPropagationTimeoutValue: 10 * time.Second}
+
+	calls := 0
+	out, err := retryOnIamPropagation(meta, "dax", func() (interface{}, error) {
+		calls++
+		if calls == 1 {
+			return nil, awserr.New("InvalidParameterValueException", "No permission to assume role: arn:aws:iam::123456789012:role/r", nil)
+		}
+		return "ok", nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if out.(string) != "ok" || calls != 2 {
+		t.Fatalf("expected a single retry, got %d calls returning %v", calls, out)
+	}
+
+	calls = 0
+	_, err = retryOnIamPropagation(meta, "dax", func() (interface{}, error) {
+		calls++
+		return nil, awserr.New("InvalidParameterValueException", "Invalid node type", nil)
+	})
+	if err == nil || calls != 1 {
+		t.Fatalf("expected an immediate error, got %d calls and %v", calls, err)
+	}
+}
+
+func TestRetryOnIamPropagationWithMinimum(t *testing.T) {
+	meta := &AWSClient{iamPropagationTimeoutValue: time.Nanosecond}
+
+	calls := 0
+	out, err := retryOnIamPropagationWithMinimum(meta, "dax", 10*time.Second, func() (interface{}, error) {
+		calls++
+		if calls == 1 {
+			return nil, awserr.New("InvalidParameterValueException", "No permission to assume role: arn:aws:iam::123456789012:role/r", nil)
+		}
+		return "ok", nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if out.(string) != "ok" || calls != 2 {
+		t.Fatalf("expected a single retry, got %d calls returning %v", calls, out)
+	}
+}
+
+func TestAWSClientIamPropagationTimeout(t *testing.T) {
+	if actual := (&AWSClient{}).iamPropagationTimeout(); actual != defaultIamPropagationTimeout {
+		t.Fatalf("expected default timeout %s, got %s", defaultIamPropagationTimeout, actual)
+	}
+	if actual := (&AWSClient{iamPropagationTimeoutValue: time.Minute}).iamPropagationTimeout(); actual != time.Minute {
+		t.Fatalf("expected configured timeout %s, got %s", time.Minute, actual)
+	}
+}
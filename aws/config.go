@@ -106,6 +106,8 @@ type Config struct {
 	Region        string
 	MaxRetries    int
 
+	IamPropagationTimeout time.Duration
+
 	AssumeRoleARN         string
 	AssumeRoleExternalID  string
 	AssumeRoleSessionName string
@@ -229,6 +231,9 @@ type AWSClient struct {
 	appsyncconn           *appsync.AppSync
 	lexmodelconn          *lexmodelbuildingservice.LexModelBuildingService
 	budgetconn            *budgets.Budgets
+
+	iamPropagationTimeoutValue time.Duration
+	resourceOwnership          *resourceOwnership
 }
 
 func (c *AWSClient) S3() *s3.S3 {
@@ -267,6 +272,8 @@ func (c *Config) Client() (interface{}, error) {
 	// store AWS region in client struct, for region specific operations such as
 	// bucket storage in S3
 	client.region = c.Region
+	client.resourceOwnership = newResourceOwnership()
+	client.iamPropagationTimeoutValue = c.IamPropagationTimeout
 
 	log.Println("[INFO] Building AWS auth structure")
 	creds, err := GetCredentials(c)
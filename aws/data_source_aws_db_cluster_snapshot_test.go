@@ -0,0 +1,38 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSDbClusterSnapshotDataSource_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	dataSourceName := "data.aws_db_cluster_snapshot.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAwsDbClusterSnapshotDataSourceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "db_cluster_snapshot_arn", "aws_db_cluster_snapshot.test", "db_cluster_snapshot_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "snapshot_type", "manual"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "snapshot_create_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDbClusterSnapshotDataSourceConfig(rInt int) string {
+	return testAccAwsDbClusterSnapshotConfig(rInt) + `
+data "aws_db_cluster_snapshot" "test" {
+  db_cluster_identifier = "${aws_db_cluster_snapshot.test.db_cluster_identifier}"
+  snapshot_type         = "manual"
+  most_recent           = true
+}
+`
+}
@@ -137,6 +137,16 @@ func dataSourceAwsDbInstance() *schema.Resource {
 				Computed: true,
 			},
 
+			"performance_insights_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"performance_insights_kms_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"multi_az": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -267,6 +277,8 @@ func dataSourceAwsDbInstanceRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("master_username", dbInstance.MasterUsername)
 	d.Set("monitoring_interval", dbInstance.MonitoringInterval)
 	d.Set("monitoring_role_arn", dbInstance.MonitoringRoleArn)
+	d.Set("performance_insights_enabled", dbInstance.PerformanceInsightsEnabled)
+	d.Set("performance_insights_kms_key_id", dbInstance.PerformanceInsightsKMSKeyId)
 	d.Set("address", dbInstance.Endpoint.Address)
 	d.Set("port", dbInstance.Endpoint.Port)
 	d.Set("hosted_zone_id", dbInstance.Endpoint.HostedZoneId)
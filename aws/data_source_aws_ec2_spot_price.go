@@ -0,0 +1,156 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsEc2SpotPrice() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsEc2SpotPriceRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": ec2CustomFiltersSchema(),
+			"instance_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"product_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"spot_price": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"spot_price_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"spot_prices": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"product_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"spot_price": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsEc2SpotPriceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	// Asking for the history starting now returns the price currently in
+	// effect for every matching availability zone, instance type and
+	// product description combination.
+	now := time.Now()
+	req := &ec2.DescribeSpotPriceHistoryInput{
+		StartTime: aws.Time(now),
+		EndTime:   aws.Time(now),
+	}
+
+	if v, ok := d.GetOk("instance_type"); ok {
+		req.InstanceTypes = []*string{aws.String(v.(string))}
+	}
+	if v, ok := d.GetOk("availability_zone"); ok {
+		req.AvailabilityZone = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("product_description"); ok {
+		req.ProductDescriptions = []*string{aws.String(v.(string))}
+	}
+
+	req.Filters = buildEC2CustomFilterList(d.Get("filter").(*schema.Set))
+	if len(req.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		req.Filters = nil
+	}
+
+	log.Printf("[DEBUG] Reading EC2 spot price history: %s", req)
+
+	latest := make(map[string]*ec2.SpotPrice)
+	err := conn.DescribeSpotPriceHistoryPages(req, func(page *ec2.DescribeSpotPriceHistoryOutput, lastPage bool) bool {
+		for _, price := range page.SpotPriceHistory {
+			key := fmt.Sprintf("%s/%s/%s",
+				aws.StringValue(price.AvailabilityZone),
+				aws.StringValue(price.InstanceType),
+				aws.StringValue(price.ProductDescription))
+			if current, ok := latest[key]; !ok || aws.TimeValue(price.Timestamp).After(aws.TimeValue(current.Timestamp)) {
+				latest[key] = price
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading EC2 spot price history: %s", err)
+	}
+
+	if len(latest) == 0 {
+		return fmt.Errorf("no EC2 spot price history found matching criteria; try different search")
+	}
+
+	keys := make([]string, 0, len(latest))
+	for k := range latest {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	spotPrices := make([]map[string]interface{}, 0, len(keys))
+	for _, k := range keys {
+		price := latest[k]
+		spotPrices = append(spotPrices, map[string]interface{}{
+			"availability_zone":   aws.StringValue(price.AvailabilityZone),
+			"instance_type":       aws.StringValue(price.InstanceType),
+			"product_description": aws.StringValue(price.ProductDescription),
+			"spot_price":          aws.StringValue(price.SpotPrice),
+			"timestamp":           aws.TimeValue(price.Timestamp).Format(time.RFC3339),
+		})
+	}
+
+	d.SetId(time.Now().UTC().String())
+	if err := d.Set("spot_prices", spotPrices); err != nil {
+		return fmt.Errorf("Error setting spot_prices: %s", err)
+	}
+
+	// Only a query narrowed down to a single price has a scalar result.
+	if len(spotPrices) == 1 {
+		d.Set("spot_price", spotPrices[0]["spot_price"])
+		d.Set("spot_price_timestamp", spotPrices[0]["timestamp"])
+	} else {
+		d.Set("spot_price", "")
+		d.Set("spot_price_timestamp", "")
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAwsEc2SpotPriceDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ec2_spot_price.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsEc2SpotPriceDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "spot_price", regexp.MustCompile(`^\d+\.\d+$`)),
+					resource.TestMatchResourceAttr(dataSourceName, "spot_price_timestamp", regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T`)),
+					resource.TestCheckResourceAttr(dataSourceName, "spot_prices.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsEc2SpotPriceDataSource_perAvailabilityZone(t *testing.T) {
+	dataSourceName := "data.aws_ec2_spot_price.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsEc2SpotPriceDataSourceConfigPerAvailabilityZone,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "spot_prices.0.spot_price", regexp.MustCompile(`^\d+\.\d+$`)),
+					resource.TestCheckResourceAttr(dataSourceName, "spot_prices.0.instance_type", "m5.xlarge"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAwsEc2SpotPriceDataSourceConfig = `
+data "aws_availability_zones" "available" {}
+
+data "aws_ec2_spot_price" "test" {
+  instance_type       = "m5.xlarge"
+  availability_zone   = "${data.aws_availability_zones.available.names[0]}"
+  product_description = "Linux/UNIX"
+}
+`
+
+const testAccAwsEc2SpotPriceDataSourceConfigPerAvailabilityZone = `
+data "aws_ec2_spot_price" "test" {
+  instance_type = "m5.xlarge"
+
+  filter {
+    name   = "product-description"
+    values = ["Linux/UNIX"]
+  }
+}
+`
@@ -0,0 +1,175 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsElasticacheSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsElasticacheSnapshotRead,
+
+		Schema: map[string]*schema.Schema{
+			//selection criteria
+			"snapshot_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"replication_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"snapshot_source": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"manual", "system"}, false),
+			},
+
+			"most_recent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			//Computed values returned
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"node_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"num_cache_nodes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"num_node_groups": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"snapshot_create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsElasticacheSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	params := &elasticache.DescribeSnapshotsInput{}
+	if v, ok := d.GetOk("snapshot_name"); ok {
+		params.SnapshotName = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("cluster_id"); ok {
+		params.CacheClusterId = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("replication_group_id"); ok {
+		params.ReplicationGroupId = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("snapshot_source"); ok {
+		params.SnapshotSource = aws.String(v.(string))
+	}
+
+	if params.SnapshotName == nil && params.CacheClusterId == nil && params.ReplicationGroupId == nil {
+		return fmt.Errorf("One of snapshot_name, cluster_id or replication_group_id must be assigned")
+	}
+
+	log.Printf("[DEBUG] Reading ElastiCache Snapshots: %s", params)
+	var snapshots []*elasticache.Snapshot
+	err := conn.DescribeSnapshotsPages(params, func(page *elasticache.DescribeSnapshotsOutput, lastPage bool) bool {
+		snapshots = append(snapshots, page.Snapshots...)
+		return !lastPage
+	})
+	if err != nil {
+		if !isAWSErr(err, elasticache.ErrCodeSnapshotNotFoundFault, "") {
+			return fmt.Errorf("Error retrieving ElastiCache Snapshots: %s", err)
+		}
+	}
+
+	if len(snapshots) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	var snapshot *elasticache.Snapshot
+	if len(snapshots) > 1 {
+		recent := d.Get("most_recent").(bool)
+		log.Printf("[DEBUG] aws_elasticache_snapshot - multiple results found and `most_recent` is set to: %t", recent)
+		if recent {
+			snapshot = mostRecentElasticacheSnapshot(snapshots)
+		} else {
+			return fmt.Errorf("Your query returned more than one result. Please try a more specific search criteria.")
+		}
+	} else {
+		snapshot = snapshots[0]
+	}
+
+	d.SetId(aws.StringValue(snapshot.SnapshotName))
+	d.Set("snapshot_name", snapshot.SnapshotName)
+
+	return elasticacheSnapshotAttributes(d, snapshot, meta)
+}
+
+type elasticacheSnapshotSort []*elasticache.Snapshot
+
+func (a elasticacheSnapshotSort) Len() int      { return len(a) }
+func (a elasticacheSnapshotSort) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a elasticacheSnapshotSort) Less(i, j int) bool {
+	ti := elasticacheSnapshotCreateTime(a[i])
+	tj := elasticacheSnapshotCreateTime(a[j])
+
+	// Snapshot creation can be in progress
+	if ti == nil {
+		return true
+	}
+	if tj == nil {
+		return false
+	}
+
+	return ti.Before(*tj)
+}
+
+func mostRecentElasticacheSnapshot(snapshots []*elasticache.Snapshot) *elasticache.Snapshot {
+	sortedSnapshots := snapshots
+	sort.Sort(elasticacheSnapshotSort(sortedSnapshots))
+	return sortedSnapshots[len(sortedSnapshots)-1]
+}
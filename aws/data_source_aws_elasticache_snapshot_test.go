@@ -0,0 +1,40 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSElasticacheSnapshotDataSource_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+	dataSourceName := "data.aws_elasticache_snapshot.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAWSElasticacheSnapshotDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", "aws_elasticache_snapshot.test", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "snapshot_name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "snapshot_source", "manual"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "snapshot_create_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSElasticacheSnapshotDataSourceConfig(rName string) string {
+	return testAccAWSElasticacheSnapshotConfig(rName) + `
+data "aws_elasticache_snapshot" "test" {
+  cluster_id      = "${aws_elasticache_snapshot.test.cluster_id}"
+  snapshot_source = "manual"
+  most_recent     = true
+}
+`
+}
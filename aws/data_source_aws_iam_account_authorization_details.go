@@ -0,0 +1,387 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsIamAccountAuthorizationDetails() *schema.Resource {
+	stringList := &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+
+	inlinePolicies := &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"policy": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+
+	// principalSchema returns the attributes common to users, groups and
+	// roles, merged with extra.
+	principalSchema := func(extra map[string]*schema.Schema) *schema.Schema {
+		s := map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"unique_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"managed_policy_arns": stringList,
+			"inline_policies":     inlinePolicies,
+		}
+		for k, v := range extra {
+			s[k] = v
+		}
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Resource{Schema: s},
+		}
+	}
+
+	return &schema.Resource{
+		Read: dataSourceAwsIamAccountAuthorizationDetailsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						iam.EntityTypeUser,
+						iam.EntityTypeRole,
+						iam.EntityTypeGroup,
+						iam.EntityTypeLocalManagedPolicy,
+						iam.EntityTypeAwsmanagedPolicy,
+					}, false),
+				},
+			},
+			"users": principalSchema(map[string]*schema.Schema{
+				"group_names": stringList,
+			}),
+			"groups": principalSchema(nil),
+			"roles": principalSchema(map[string]*schema.Schema{
+				"assume_role_policy": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"instance_profile_arns": stringList,
+			}),
+			"instance_profiles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"unique_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role_names": stringList,
+					},
+				},
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"unique_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"attachment_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"is_attachable": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"default_version_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsIamAccountAuthorizationDetailsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	input := &iam.GetAccountAuthorizationDetailsInput{}
+	filter := expandStringList(d.Get("filter").([]interface{}))
+	if len(filter) > 0 {
+		input.Filter = filter
+	}
+
+	var users []*iam.UserDetail
+	var groups []*iam.GroupDetail
+	var roles []*iam.RoleDetail
+	var policies []*iam.ManagedPolicyDetail
+
+	log.Printf("[DEBUG] Reading IAM account authorization details: %s", input)
+	err := conn.GetAccountAuthorizationDetailsPages(input, func(page *iam.GetAccountAuthorizationDetailsOutput, lastPage bool) bool {
+		users = append(users, page.UserDetailList...)
+		groups = append(groups, page.GroupDetailList...)
+		roles = append(roles, page.RoleDetailList...)
+		policies = append(policies, page.Policies...)
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading IAM account authorization details: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(strings.Join(aws.StringValueSlice(filter), ","))))
+	if err := d.Set("users", flattenIamAuthorizationUserDetails(users)); err != nil {
+		return fmt.Errorf("error setting users: %s", err)
+	}
+	if err := d.Set("groups", flattenIamAuthorizationGroupDetails(groups)); err != nil {
+		return fmt.Errorf("error setting groups: %s", err)
+	}
+	if err := d.Set("roles", flattenIamAuthorizationRoleDetails(roles)); err != nil {
+		return fmt.Errorf("error setting roles: %s", err)
+	}
+	if err := d.Set("instance_profiles", flattenIamAuthorizationInstanceProfiles(roles)); err != nil {
+		return fmt.Errorf("error setting instance_profiles: %s", err)
+	}
+	if err := d.Set("policies", flattenIamAuthorizationManagedPolicyDetails(policies)); err != nil {
+		return fmt.Errorf("error setting policies: %s", err)
+	}
+
+	return nil
+}
+
+func flattenIamAuthorizationUserDetails(users []*iam.UserDetail) []map[string]interface{} {
+	l := make([]map[string]interface{}, 0, len(users))
+	for _, u := range users {
+		l = append(l, map[string]interface{}{
+			"name":                aws.StringValue(u.UserName),
+			"arn":                 aws.StringValue(u.Arn),
+			"path":                aws.StringValue(u.Path),
+			"unique_id":           aws.StringValue(u.UserId),
+			"create_date":         flattenIamAuthorizationDate(u.CreateDate),
+			"group_names":         aws.StringValueSlice(u.GroupList),
+			"managed_policy_arns": flattenIamAuthorizationAttachedPolicies(u.AttachedManagedPolicies),
+			"inline_policies":     flattenIamAuthorizationInlinePolicies(u.UserPolicyList),
+		})
+	}
+	return l
+}
+
+func flattenIamAuthorizationGroupDetails(groups []*iam.GroupDetail) []map[string]interface{} {
+	l := make([]map[string]interface{}, 0, len(groups))
+	for _, g := range groups {
+		l = append(l, map[string]interface{}{
+			"name":                aws.StringValue(g.GroupName),
+			"arn":                 aws.StringValue(g.Arn),
+			"path":                aws.StringValue(g.Path),
+			"unique_id":           aws.StringValue(g.GroupId),
+			"create_date":         flattenIamAuthorizationDate(g.CreateDate),
+			"managed_policy_arns": flattenIamAuthorizationAttachedPolicies(g.AttachedManagedPolicies),
+			"inline_policies":     flattenIamAuthorizationInlinePolicies(g.GroupPolicyList),
+		})
+	}
+	return l
+}
+
+func flattenIamAuthorizationRoleDetails(roles []*iam.RoleDetail) []map[string]interface{} {
+	l := make([]map[string]interface{}, 0, len(roles))
+	for _, r := range roles {
+		instanceProfileArns := make([]string, 0, len(r.InstanceProfileList))
+		for _, ip := range r.InstanceProfileList {
+			instanceProfileArns = append(instanceProfileArns, aws.StringValue(ip.Arn))
+		}
+
+		l = append(l, map[string]interface{}{
+			"name":                  aws.StringValue(r.RoleName),
+			"arn":                   aws.StringValue(r.Arn),
+			"path":                  aws.StringValue(r.Path),
+			"unique_id":             aws.StringValue(r.RoleId),
+			"create_date":           flattenIamAuthorizationDate(r.CreateDate),
+			"assume_role_policy":    flattenIamAuthorizationPolicyDocument(r.AssumeRolePolicyDocument),
+			"instance_profile_arns": instanceProfileArns,
+			"managed_policy_arns":   flattenIamAuthorizationAttachedPolicies(r.AttachedManagedPolicies),
+			"inline_policies":       flattenIamAuthorizationInlinePolicies(r.RolePolicyList),
+		})
+	}
+	return l
+}
+
+// flattenIamAuthorizationInstanceProfiles collects the instance profiles
+// that the account authorization details only report through their roles.
+func flattenIamAuthorizationInstanceProfiles(roles []*iam.RoleDetail) []map[string]interface{} {
+	profiles := make(map[string]*iam.InstanceProfile)
+	for _, r := range roles {
+		for _, ip := range r.InstanceProfileList {
+			profiles[aws.StringValue(ip.Arn)] = ip
+		}
+	}
+
+	arns := make([]string, 0, len(profiles))
+	for arn := range profiles {
+		arns = append(arns, arn)
+	}
+	sort.Strings(arns)
+
+	l := make([]map[string]interface{}, 0, len(arns))
+	for _, arn := range arns {
+		ip := profiles[arn]
+		roleNames := make([]string, 0, len(ip.Roles))
+		for _, r := range ip.Roles {
+			roleNames = append(roleNames, aws.StringValue(r.RoleName))
+		}
+
+		l = append(l, map[string]interface{}{
+			"name":       aws.StringValue(ip.InstanceProfileName),
+			"arn":        arn,
+			"path":       aws.StringValue(ip.Path),
+			"unique_id":  aws.StringValue(ip.InstanceProfileId),
+			"role_names": roleNames,
+		})
+	}
+	return l
+}
+
+func flattenIamAuthorizationManagedPolicyDetails(policies []*iam.ManagedPolicyDetail) []map[string]interface{} {
+	l := make([]map[string]interface{}, 0, len(policies))
+	for _, p := range policies {
+		m := map[string]interface{}{
+			"name":               aws.StringValue(p.PolicyName),
+			"arn":                aws.StringValue(p.Arn),
+			"path":               aws.StringValue(p.Path),
+			"unique_id":          aws.StringValue(p.PolicyId),
+			"description":        aws.StringValue(p.Description),
+			"attachment_count":   int(aws.Int64Value(p.AttachmentCount)),
+			"is_attachable":      aws.BoolValue(p.IsAttachable),
+			"default_version_id": aws.StringValue(p.DefaultVersionId),
+		}
+		for _, v := range p.PolicyVersionList {
+			if aws.BoolValue(v.IsDefaultVersion) {
+				m["policy"] = flattenIamAuthorizationPolicyDocument(v.Document)
+			}
+		}
+		l = append(l, m)
+	}
+	return l
+}
+
+func flattenIamAuthorizationAttachedPolicies(policies []*iam.AttachedPolicy) []string {
+	arns := make([]string, 0, len(policies))
+	for _, p := range policies {
+		arns = append(arns, aws.StringValue(p.PolicyArn))
+	}
+	return arns
+}
+
+func flattenIamAuthorizationInlinePolicies(policies []*iam.PolicyDetail) []map[string]interface{} {
+	l := make([]map[string]interface{}, 0, len(policies))
+	for _, p := range policies {
+		l = append(l, map[string]interface{}{
+			"name":   aws.StringValue(p.PolicyName),
+			"policy": flattenIamAuthorizationPolicyDocument(p.PolicyDocument),
+		})
+	}
+	return l
+}
+
+// flattenIamAuthorizationPolicyDocument decodes and normalizes a URL-encoded
+// policy document, falling back to the decoded JSON if it can't be normalized.
+func flattenIamAuthorizationPolicyDocument(document *string) string {
+	encoded := aws.StringValue(document)
+	if encoded == "" {
+		return ""
+	}
+
+	decoded, err := url.QueryUnescape(encoded)
+	if err != nil {
+		log.Printf("[WARN] Unable to decode IAM policy document %q: %s", encoded, err)
+		return encoded
+	}
+
+	normalized, err := normalizeIAMPolicyDocument(decoded)
+	if err != nil {
+		log.Printf("[WARN] Unable to normalize IAM policy document %q: %s", decoded, err)
+		return decoded
+	}
+	return normalized
+}
+
+func flattenIamAuthorizationDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
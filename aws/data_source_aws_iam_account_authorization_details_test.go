@@ -0,0 +1,178 @@
+package aws
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestNormalizeIAMPolicyDocument(t *testing.T) {
+	cases := []struct {
+		Document string
+		Expected string
+	}{
+		{
+			Document: `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		{
+			Document: `{"Statement":[{"Sid":"1","Effect":"Allow","Action":["sts:AssumeRole"],"Principal":{"AWS":["arn:aws:iam::111111111111:root","arn:aws:iam::222222222222:root"]},"Condition":{"Bool":{"aws:MultiFactorAuthPresent":true}}}],"Version":"2012-10-17"}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Sid":"1","Effect":"Allow","Action":["sts:AssumeRole"],"Principal":{"AWS":["arn:aws:iam::222222222222:root","arn:aws:iam::111111111111:root"]},"Condition":{"Bool":{"aws:MultiFactorAuthPresent":["true"]}}}]}`,
+		},
+	}
+
+	for i, tc := range cases {
+		actual, err := normalizeIAMPolicyDocument(tc.Document)
+		if err != nil {
+			t.Fatalf("%d: unexpected error: %s", i, err)
+		}
+		if actual != tc.Expected {
+			t.Fatalf("%d: expected:\n%s\ngot:\n%s", i, tc.Expected, actual)
+		}
+	}
+
+	if _, err := normalizeIAMPolicyDocument(`{"Statement":`); err == nil {
+		t.Fatal("expected an error for an invalid document")
+	}
+}
+
+func TestFlattenIamAuthorizationPolicyDocument(t *testing.T) {
+	cases := []struct {
+		Document string
+		Expected string
+	}{
+		{
+			Document: url.QueryEscape(`{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}}`),
+			Expected: `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		// Documents that can't be normalized are returned decoded.
+		{
+			Document: url.QueryEscape(`{"Version":"2012-10-17","Statement":"invalid"}`),
+			Expected: `{"Version":"2012-10-17","Statement":"invalid"}`,
+		},
+		{
+			Document: "",
+			Expected: "",
+		},
+	}
+
+	for i, tc := range cases {
+		actual := flattenIamAuthorizationPolicyDocument(aws.String(tc.Document))
+		if actual != tc.Expected {
+			t.Fatalf("%d: expected:\n%s\ngot:\n%s", i, tc.Expected, actual)
+		}
+	}
+}
+
+func TestAccAWSDataSourceIAMAccountAuthorizationDetails_basic(t *testing.T) {
+	rName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsIAMAccountAuthorizationDetailsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsIAMAccountAuthorizationDetailsRole("data.aws_iam_account_authorization_details.test", fmt.Sprintf("tf-acc-%s", rName)),
+					resource.TestCheckResourceAttr("data.aws_iam_account_authorization_details.test", "users.#", "0"),
+					resource.TestCheckResourceAttr("data.aws_iam_account_authorization_details.test", "groups.#", "0"),
+					resource.TestCheckResourceAttr("data.aws_iam_account_authorization_details.test", "policies.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckAwsIAMAccountAuthorizationDetailsRole checks that the named
+// role is reported with its inline policy, instance profile and normalized
+// assume role policy.
+func testAccCheckAwsIAMAccountAuthorizationDetailsRole(n, roleName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		attrs := rs.Primary.Attributes
+		count, err := strconv.Atoi(attrs["roles.#"])
+		if err != nil {
+			return err
+		}
+		for i := 0; i < count; i++ {
+			prefix := fmt.Sprintf("roles.%d.", i)
+			if attrs[prefix+"name"] != roleName {
+				continue
+			}
+
+			if attrs[prefix+"inline_policies.#"] != "1" || attrs[prefix+"inline_policies.0.name"] != roleName {
+				return fmt.Errorf("Expected inline policy %q on role %q, got %v", roleName, roleName, attrs)
+			}
+			if attrs[prefix+"instance_profile_arns.#"] != "1" {
+				return fmt.Errorf("Expected one instance profile on role %q, got %q", roleName, attrs[prefix+"instance_profile_arns.#"])
+			}
+			expected := `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":"sts:AssumeRole","Principal":{"Service":"ec2.amazonaws.com"}}]}`
+			if actual := attrs[prefix+"assume_role_policy"]; actual != expected {
+				return fmt.Errorf("Expected assume_role_policy %s, got %s", expected, actual)
+			}
+			return nil
+		}
+
+		return fmt.Errorf("Role %q not found in %s", roleName, n)
+	}
+}
+
+func testAccAwsIAMAccountAuthorizationDetailsConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = "tf-acc-%[1]s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Action": "sts:AssumeRole",
+    "Principal": {
+      "Service": "ec2.amazonaws.com"
+    },
+    "Effect": "Allow"
+  }
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = "tf-acc-%[1]s"
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "ec2:Describe*",
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_instance_profile" "test" {
+  name = "tf-acc-%[1]s"
+  role = "${aws_iam_role.test.name}"
+}
+
+data "aws_iam_account_authorization_details" "test" {
+  filter     = ["Role"]
+  depends_on = ["aws_iam_role_policy.test", "aws_iam_instance_profile.test"]
+}
+`, rName)
+}
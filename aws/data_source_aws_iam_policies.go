@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsIAMPolicies() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsIAMPoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"path_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+			"scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  iam.PolicyScopeTypeAll,
+				ValidateFunc: validation.StringInSlice([]string{
+					iam.PolicyScopeTypeAll,
+					iam.PolicyScopeTypeAws,
+					iam.PolicyScopeTypeLocal,
+				}, false),
+			},
+			"only_attached": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsIAMPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	pathPrefix := d.Get("path_prefix").(string)
+	nameRegex := d.Get("name_regex").(string)
+	scope := d.Get("scope").(string)
+	onlyAttached := d.Get("only_attached").(bool)
+
+	input := &iam.ListPoliciesInput{
+		Scope:        aws.String(scope),
+		OnlyAttached: aws.Bool(onlyAttached),
+	}
+	if pathPrefix != "" {
+		input.PathPrefix = aws.String(pathPrefix)
+	}
+
+	var r *regexp.Regexp
+	if nameRegex != "" {
+		r = regexp.MustCompile(nameRegex)
+	}
+
+	names := make([]string, 0)
+	arns := make([]string, 0)
+
+	log.Printf("[DEBUG] Listing IAM policies: %s", input)
+	err := conn.ListPoliciesPages(input, func(page *iam.ListPoliciesOutput, lastPage bool) bool {
+		for _, policy := range page.Policies {
+			name := aws.StringValue(policy.PolicyName)
+			if r != nil && !r.MatchString(name) {
+				continue
+			}
+			names = append(names, name)
+			arns = append(arns, aws.StringValue(policy.Arn))
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing IAM policies: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(pathPrefix+nameRegex+scope+strconv.FormatBool(onlyAttached))))
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("error setting names: %s", err)
+	}
+	if err := d.Set("arns", arns); err != nil {
+		return fmt.Errorf("error setting arns: %s", err)
+	}
+
+	return nil
+}
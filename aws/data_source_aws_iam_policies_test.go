@@ -0,0 +1,87 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSDataSourceIAMPolicies_basic(t *testing.T) {
+	rName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsIAMPoliciesConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_policies.path", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_iam_policies.path", "arns.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_iam_policies.regex", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_iam_policies.regex", "names.0", fmt.Sprintf("tf-acc-ci-%s", rName)),
+					resource.TestCheckResourceAttrPair("data.aws_iam_policies.regex", "arns.0", "aws_iam_policy.ci", "arn"),
+					resource.TestCheckResourceAttr("data.aws_iam_policies.attached", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_iam_policies.attached", "names.0", fmt.Sprintf("tf-acc-app-%s", rName)),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsIAMPoliciesConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_policy" "ci" {
+  name = "tf-acc-ci-%[1]s"
+  path = "/tf-acc-%[1]s/"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "ec2:Describe*",
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_policy" "app" {
+  name   = "tf-acc-app-%[1]s"
+  path   = "/tf-acc-%[1]s/"
+  policy = "${aws_iam_policy.ci.policy}"
+}
+
+resource "aws_iam_user" "test" {
+  name = "tf-acc-%[1]s"
+}
+
+resource "aws_iam_user_policy_attachment" "test" {
+  user       = "${aws_iam_user.test.name}"
+  policy_arn = "${aws_iam_policy.app.arn}"
+}
+
+data "aws_iam_policies" "path" {
+  path_prefix = "${aws_iam_policy.ci.path}"
+  scope       = "Local"
+  depends_on  = ["aws_iam_policy.app"]
+}
+
+data "aws_iam_policies" "regex" {
+  path_prefix = "${aws_iam_policy.ci.path}"
+  name_regex  = "^tf-acc-ci-"
+  depends_on  = ["aws_iam_policy.app"]
+}
+
+data "aws_iam_policies" "attached" {
+  path_prefix   = "${aws_iam_policy.ci.path}"
+  only_attached = true
+  depends_on    = ["aws_iam_user_policy_attachment.test"]
+}
+`, rName)
+}
@@ -2,9 +2,12 @@ package aws
 
 import (
 	"encoding/json"
+	"fmt"
+	"log"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -178,6 +181,14 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 	}
 	jsonString := string(jsonDoc)
 
+	warnings, errors := validateIAMPolicyDocument(jsonString, iamPolicyDocumentAny, meta.(*AWSClient).partition)
+	for _, w := range warnings {
+		log.Printf("[WARN] IAM policy document: %s", w)
+	}
+	if len(errors) > 0 {
+		return fmt.Errorf("invalid IAM policy document: %s", multierror.Append(nil, errors...))
+	}
+
 	d.Set("json", jsonString)
 	d.SetId(strconv.Itoa(hashcode.String(jsonString)))
 
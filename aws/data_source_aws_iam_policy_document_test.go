@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -90,6 +91,19 @@ func TestAccAWSDataSourceIAMPolicyDocument_override(t *testing.T) {
 	})
 }
 
+func TestAccAWSDataSourceIAMPolicyDocument_invalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSIAMPolicyDocumentInvalidConfig,
+				ExpectError: regexp.MustCompile(`Statement\[0\] \(Sid "1"\)\.Condition\.StringEqual: unknown condition operator`),
+			},
+		},
+	})
+}
+
 func testAccCheckStateValue(id, name, value string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[id]
@@ -520,3 +534,19 @@ var testAccAWSIAMPolicyDocumentOverrideExpectedJSON = `{
     }
   ]
 }`
+
+var testAccAWSIAMPolicyDocumentInvalidConfig = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    sid       = "1"
+    actions   = ["s3:ListBucket"]
+    resources = ["arn:aws:s3:::foo"]
+
+    condition {
+      test     = "StringEqual"
+      variable = "s3:prefix"
+      values   = ["home/"]
+    }
+  }
+}
+`
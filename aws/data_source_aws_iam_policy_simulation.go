@@ -0,0 +1,295 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsIamPolicySimulation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsIamPolicySimulationRead,
+
+		Schema: map[string]*schema.Schema{
+			"policy_source_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"policy_input_list": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateIAMIdentityPolicyJson,
+				},
+			},
+			"action_names": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_arns": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIAMResourcePolicyJson,
+			},
+			"resource_owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"caller_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"resource_handling_option": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"context": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								iam.ContextKeyTypeEnumString,
+								iam.ContextKeyTypeEnumStringList,
+								iam.ContextKeyTypeEnumNumeric,
+								iam.ContextKeyTypeEnumNumericList,
+								iam.ContextKeyTypeEnumBoolean,
+								iam.ContextKeyTypeEnumBooleanList,
+								iam.ContextKeyTypeEnumIp,
+								iam.ContextKeyTypeEnumIpList,
+								iam.ContextKeyTypeEnumBinary,
+								iam.ContextKeyTypeEnumBinaryList,
+								iam.ContextKeyTypeEnumDate,
+								iam.ContextKeyTypeEnumDateList,
+							}, false),
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"all_allowed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"decision": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"allowed": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"decision_details": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+						"allowed_by_organizations": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"matched_statements": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"source_policy_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"source_policy_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"missing_context_keys": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsIamPolicySimulationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	policySourceArn := d.Get("policy_source_arn").(string)
+	policyInputList := expandStringList(d.Get("policy_input_list").([]interface{}))
+	if policySourceArn == "" && len(policyInputList) == 0 {
+		return fmt.Errorf("one of `policy_source_arn` or `policy_input_list` must be set")
+	}
+
+	actionNames := expandStringList(d.Get("action_names").([]interface{}))
+	contextEntries := expandIamPolicySimulationContextEntries(d.Get("context").([]interface{}))
+
+	var resourceArns []*string
+	if v, ok := d.GetOk("resource_arns"); ok {
+		resourceArns = expandStringList(v.([]interface{}))
+	}
+
+	var resourcePolicy, resourceOwner, callerArn, resourceHandlingOption *string
+	if v, ok := d.GetOk("resource_policy"); ok {
+		resourcePolicy = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("resource_owner"); ok {
+		resourceOwner = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("caller_arn"); ok {
+		callerArn = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("resource_handling_option"); ok {
+		resourceHandlingOption = aws.String(v.(string))
+	}
+
+	var results []*iam.EvaluationResult
+	collect := func(page *iam.SimulatePolicyResponse, lastPage bool) bool {
+		results = append(results, page.EvaluationResults...)
+		return !lastPage
+	}
+
+	var err error
+	if policySourceArn != "" {
+		input := &iam.SimulatePrincipalPolicyInput{
+			PolicySourceArn:        aws.String(policySourceArn),
+			ActionNames:            actionNames,
+			ContextEntries:         contextEntries,
+			CallerArn:              callerArn,
+			ResourceArns:           resourceArns,
+			ResourceHandlingOption: resourceHandlingOption,
+			ResourceOwner:          resourceOwner,
+			ResourcePolicy:         resourcePolicy,
+		}
+		if len(policyInputList) > 0 {
+			input.PolicyInputList = policyInputList
+		}
+
+		log.Printf("[DEBUG] Simulating IAM principal policy: %s", input)
+		err = conn.SimulatePrincipalPolicyPages(input, collect)
+	} else {
+		input := &iam.SimulateCustomPolicyInput{
+			PolicyInputList:        policyInputList,
+			ActionNames:            actionNames,
+			ContextEntries:         contextEntries,
+			CallerArn:              callerArn,
+			ResourceArns:           resourceArns,
+			ResourceHandlingOption: resourceHandlingOption,
+			ResourceOwner:          resourceOwner,
+			ResourcePolicy:         resourcePolicy,
+		}
+
+		log.Printf("[DEBUG] Simulating IAM custom policy: %s", input)
+		err = conn.SimulateCustomPolicyPages(input, collect)
+	}
+	if err != nil {
+		return fmt.Errorf("Error simulating IAM policy: %s", err)
+	}
+
+	allAllowed := true
+	for _, r := range results {
+		if aws.StringValue(r.EvalDecision) != iam.PolicyEvaluationDecisionTypeAllowed {
+			allAllowed = false
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(policySourceArn+strings.Join(aws.StringValueSlice(policyInputList), "")+
+		strings.Join(aws.StringValueSlice(actionNames), ",")+strings.Join(aws.StringValueSlice(resourceArns), ","))))
+	d.Set("all_allowed", allAllowed)
+	if err := d.Set("results", flattenIamPolicySimulationResults(results)); err != nil {
+		return fmt.Errorf("error setting results: %s", err)
+	}
+
+	return nil
+}
+
+func expandIamPolicySimulationContextEntries(l []interface{}) []*iam.ContextEntry {
+	if len(l) == 0 {
+		return nil
+	}
+
+	entries := make([]*iam.ContextEntry, 0, len(l))
+	for _, v := range l {
+		m := v.(map[string]interface{})
+		entries = append(entries, &iam.ContextEntry{
+			ContextKeyName:   aws.String(m["key"].(string)),
+			ContextKeyType:   aws.String(m["type"].(string)),
+			ContextKeyValues: expandStringList(m["values"].([]interface{})),
+		})
+	}
+
+	return entries
+}
+
+func flattenIamPolicySimulationResults(results []*iam.EvaluationResult) []map[string]interface{} {
+	l := make([]map[string]interface{}, 0, len(results))
+	for _, r := range results {
+		m := map[string]interface{}{
+			"action_name":          aws.StringValue(r.EvalActionName),
+			"resource_arn":         aws.StringValue(r.EvalResourceName),
+			"decision":             aws.StringValue(r.EvalDecision),
+			"allowed":              aws.StringValue(r.EvalDecision) == iam.PolicyEvaluationDecisionTypeAllowed,
+			"decision_details":     aws.StringValueMap(r.EvalDecisionDetails),
+			"missing_context_keys": flattenStringList(r.MissingContextValues),
+		}
+		if r.OrganizationsDecisionDetail != nil {
+			m["allowed_by_organizations"] = aws.BoolValue(r.OrganizationsDecisionDetail.AllowedByOrganizations)
+		}
+
+		statements := make([]map[string]interface{}, 0, len(r.MatchedStatements))
+		for _, s := range r.MatchedStatements {
+			statements = append(statements, map[string]interface{}{
+				"source_policy_id":   aws.StringValue(s.SourcePolicyId),
+				"source_policy_type": aws.StringValue(s.SourcePolicyType),
+			})
+		}
+		m["matched_statements"] = statements
+
+		l = append(l, m)
+	}
+
+	return l
+}
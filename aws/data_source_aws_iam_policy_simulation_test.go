@@ -0,0 +1,117 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSDataSourceIAMPolicySimulation_custom(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDataSourceIamPolicySimulationCustomConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_policy_simulation.test", "all_allowed", "false"),
+					resource.TestCheckResourceAttr("data.aws_iam_policy_simulation.test", "results.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_iam_policy_simulation.test", "results.0.action_name", "s3:GetObject"),
+					resource.TestCheckResourceAttr("data.aws_iam_policy_simulation.test", "results.0.decision", "allowed"),
+					resource.TestCheckResourceAttr("data.aws_iam_policy_simulation.test", "results.0.allowed", "true"),
+					resource.TestCheckResourceAttr("data.aws_iam_policy_simulation.test", "results.0.matched_statements.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_iam_policy_simulation.test", "results.1.action_name", "s3:DeleteObject"),
+					resource.TestCheckResourceAttr("data.aws_iam_policy_simulation.test", "results.1.decision", "explicitDeny"),
+					resource.TestCheckResourceAttr("data.aws_iam_policy_simulation.test", "results.1.allowed", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceIAMPolicySimulation_principal(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDataSourceIamPolicySimulationPrincipalConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_policy_simulation.test", "all_allowed", "true"),
+					resource.TestCheckResourceAttr("data.aws_iam_policy_simulation.test", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_iam_policy_simulation.test", "results.0.action_name", "ec2:DescribeInstances"),
+					resource.TestCheckResourceAttr("data.aws_iam_policy_simulation.test", "results.0.decision", "allowed"),
+					resource.TestCheckResourceAttr("data.aws_iam_policy_simulation.test", "results.0.missing_context_keys.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAwsDataSourceIamPolicySimulationCustomConfig = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["s3:*"]
+    resources = ["*"]
+  }
+
+  statement {
+    effect    = "Deny"
+    actions   = ["s3:DeleteObject"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_simulation" "test" {
+  policy_input_list = ["${data.aws_iam_policy_document.test.json}"]
+  action_names      = ["s3:GetObject", "s3:DeleteObject"]
+}
+`
+
+func testAccAwsDataSourceIamPolicySimulationPrincipalConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_user_policy" "test" {
+  name = %[1]q
+  user = "${aws_iam_user.test.name}"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "ec2:Describe*",
+      "Resource": "*",
+      "Condition": {
+        "IpAddress": {
+          "aws:SourceIp": "203.0.113.0/24"
+        }
+      }
+    }
+  ]
+}
+POLICY
+}
+
+data "aws_iam_policy_simulation" "test" {
+  policy_source_arn = "${aws_iam_user.test.arn}"
+  action_names      = ["ec2:DescribeInstances"]
+
+  context {
+    key    = "aws:SourceIp"
+    type   = "ip"
+    values = ["203.0.113.10"]
+  }
+
+  depends_on = ["aws_iam_user_policy.test"]
+}
+`, rName)
+}
@@ -0,0 +1,136 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsIAMRoles() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsIAMRolesRead,
+
+		Schema: map[string]*schema.Schema{
+			"path_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+			"policy_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsIAMRolesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	pathPrefix := d.Get("path_prefix").(string)
+	nameRegex := d.Get("name_regex").(string)
+	policyArn := d.Get("policy_arn").(string)
+
+	var attached map[string]bool
+	if policyArn != "" {
+		var err error
+		attached, err = iamListEntityNamesForPolicy(conn, policyArn, iam.EntityTypeRole, pathPrefix)
+		if err != nil {
+			return err
+		}
+	}
+
+	input := &iam.ListRolesInput{}
+	if pathPrefix != "" {
+		input.PathPrefix = aws.String(pathPrefix)
+	}
+
+	var r *regexp.Regexp
+	if nameRegex != "" {
+		r = regexp.MustCompile(nameRegex)
+	}
+
+	names := make([]string, 0)
+	arns := make([]string, 0)
+
+	log.Printf("[DEBUG] Listing IAM roles: %s", input)
+	err := conn.ListRolesPages(input, func(page *iam.ListRolesOutput, lastPage bool) bool {
+		for _, role := range page.Roles {
+			name := aws.StringValue(role.RoleName)
+			if r != nil && !r.MatchString(name) {
+				continue
+			}
+			if attached != nil && !attached[name] {
+				continue
+			}
+			names = append(names, name)
+			arns = append(arns, aws.StringValue(role.Arn))
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing IAM roles: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(pathPrefix+nameRegex+policyArn)))
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("error setting names: %s", err)
+	}
+	if err := d.Set("arns", arns); err != nil {
+		return fmt.Errorf("error setting arns: %s", err)
+	}
+
+	return nil
+}
+
+// iamListEntityNamesForPolicy returns the names of the entities of the given
+// type (role, user or group) that the managed policy is attached to.
+func iamListEntityNamesForPolicy(conn *iam.IAM, policyArn, entityType, pathPrefix string) (map[string]bool, error) {
+	input := &iam.ListEntitiesForPolicyInput{
+		PolicyArn:    aws.String(policyArn),
+		EntityFilter: aws.String(entityType),
+	}
+	if pathPrefix != "" {
+		input.PathPrefix = aws.String(pathPrefix)
+	}
+
+	names := make(map[string]bool)
+	err := conn.ListEntitiesForPolicyPages(input, func(page *iam.ListEntitiesForPolicyOutput, lastPage bool) bool {
+		for _, r := range page.PolicyRoles {
+			names[aws.StringValue(r.RoleName)] = true
+		}
+		for _, u := range page.PolicyUsers {
+			names[aws.StringValue(u.UserName)] = true
+		}
+		for _, g := range page.PolicyGroups {
+			names[aws.StringValue(g.GroupName)] = true
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error listing entities for IAM policy %s: %s", policyArn, err)
+	}
+
+	return names, nil
+}
@@ -0,0 +1,100 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSDataSourceIAMRoles_basic(t *testing.T) {
+	rName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsIAMRolesConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_roles.path", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_iam_roles.path", "arns.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_iam_roles.regex", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_iam_roles.regex", "names.0", fmt.Sprintf("tf-acc-ci-%s", rName)),
+					resource.TestCheckResourceAttrPair("data.aws_iam_roles.regex", "arns.0", "aws_iam_role.ci", "arn"),
+					resource.TestCheckResourceAttr("data.aws_iam_roles.policy", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_iam_roles.policy", "names.0", fmt.Sprintf("tf-acc-app-%s", rName)),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsIAMRolesConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "ci" {
+  name = "tf-acc-ci-%[1]s"
+  path = "/tf-acc-%[1]s/"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "ec2.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role" "app" {
+  name = "tf-acc-app-%[1]s"
+  path = "/tf-acc-%[1]s/"
+
+  assume_role_policy = "${aws_iam_role.ci.assume_role_policy}"
+}
+
+resource "aws_iam_policy" "test" {
+  name = "tf-acc-%[1]s"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "ec2:Describe*",
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = "${aws_iam_role.app.name}"
+  policy_arn = "${aws_iam_policy.test.arn}"
+}
+
+data "aws_iam_roles" "path" {
+  path_prefix = "${aws_iam_role.ci.path}"
+  depends_on  = ["aws_iam_role.app"]
+}
+
+data "aws_iam_roles" "regex" {
+  path_prefix = "${aws_iam_role.ci.path}"
+  name_regex  = "^tf-acc-ci-"
+  depends_on  = ["aws_iam_role.app"]
+}
+
+data "aws_iam_roles" "policy" {
+  policy_arn = "${aws_iam_role_policy_attachment.test.policy_arn}"
+}
+`, rName)
+}
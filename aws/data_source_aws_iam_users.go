@@ -0,0 +1,105 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsIAMUsers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsIAMUsersRead,
+
+		Schema: map[string]*schema.Schema{
+			"path_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+			"policy_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsIAMUsersRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	pathPrefix := d.Get("path_prefix").(string)
+	nameRegex := d.Get("name_regex").(string)
+	policyArn := d.Get("policy_arn").(string)
+
+	var attached map[string]bool
+	if policyArn != "" {
+		var err error
+		attached, err = iamListEntityNamesForPolicy(conn, policyArn, iam.EntityTypeUser, pathPrefix)
+		if err != nil {
+			return err
+		}
+	}
+
+	input := &iam.ListUsersInput{}
+	if pathPrefix != "" {
+		input.PathPrefix = aws.String(pathPrefix)
+	}
+
+	var r *regexp.Regexp
+	if nameRegex != "" {
+		r = regexp.MustCompile(nameRegex)
+	}
+
+	names := make([]string, 0)
+	arns := make([]string, 0)
+
+	log.Printf("[DEBUG] Listing IAM users: %s", input)
+	err := conn.ListUsersPages(input, func(page *iam.ListUsersOutput, lastPage bool) bool {
+		for _, user := range page.Users {
+			name := aws.StringValue(user.UserName)
+			if r != nil && !r.MatchString(name) {
+				continue
+			}
+			if attached != nil && !attached[name] {
+				continue
+			}
+			names = append(names, name)
+			arns = append(arns, aws.StringValue(user.Arn))
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing IAM users: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(pathPrefix+nameRegex+policyArn)))
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("error setting names: %s", err)
+	}
+	if err := d.Set("arns", arns); err != nil {
+		return fmt.Errorf("error setting arns: %s", err)
+	}
+
+	return nil
+}
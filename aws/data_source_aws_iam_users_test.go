@@ -0,0 +1,83 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSDataSourceIAMUsers_basic(t *testing.T) {
+	rName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsIAMUsersConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_users.path", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_iam_users.path", "arns.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_iam_users.regex", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_iam_users.regex", "names.0", fmt.Sprintf("tf-acc-ci-%s", rName)),
+					resource.TestCheckResourceAttrPair("data.aws_iam_users.regex", "arns.0", "aws_iam_user.ci", "arn"),
+					resource.TestCheckResourceAttr("data.aws_iam_users.policy", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_iam_users.policy", "names.0", fmt.Sprintf("tf-acc-app-%s", rName)),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsIAMUsersConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "ci" {
+  name = "tf-acc-ci-%[1]s"
+  path = "/tf-acc-%[1]s/"
+}
+
+resource "aws_iam_user" "app" {
+  name = "tf-acc-app-%[1]s"
+  path = "/tf-acc-%[1]s/"
+}
+
+resource "aws_iam_policy" "test" {
+  name = "tf-acc-%[1]s"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "ec2:Describe*",
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_user_policy_attachment" "test" {
+  user       = "${aws_iam_user.app.name}"
+  policy_arn = "${aws_iam_policy.test.arn}"
+}
+
+data "aws_iam_users" "path" {
+  path_prefix = "${aws_iam_user.ci.path}"
+  depends_on  = ["aws_iam_user.app"]
+}
+
+data "aws_iam_users" "regex" {
+  path_prefix = "${aws_iam_user.ci.path}"
+  name_regex  = "^tf-acc-ci-"
+  depends_on  = ["aws_iam_user.app"]
+}
+
+data "aws_iam_users" "policy" {
+  policy_arn = "${aws_iam_user_policy_attachment.test.policy_arn}"
+}
+`, rName)
+}
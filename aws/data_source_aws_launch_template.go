@@ -0,0 +1,457 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsLaunchTemplate() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsLaunchTemplateRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"filter": ec2CustomFiltersSchema(),
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "$Latest",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"latest_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"block_device_mappings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"no_device": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"virtual_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ebs": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delete_on_termination": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"encrypted": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"iops": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"kms_key_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"snapshot_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"volume_size": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"volume_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"credit_specification": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cpu_credits": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"disable_api_termination": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"ebs_optimized": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"elastic_gpu_specifications": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"iam_instance_profile": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_initiated_shutdown_behavior": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_market_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"market_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"spot_options": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"block_duration_minutes": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"instance_interruption_behavior": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"max_price": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"spot_instance_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"valid_until": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kernel_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"monitoring": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"network_interfaces": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"associate_public_ip_address": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"delete_on_termination": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_index": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"security_groups": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"ipv6_address_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"ipv6_addresses": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"network_interface_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipv4_addresses": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"ipv4_address_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"placement": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"affinity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"group_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"host_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"spread_domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenancy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ram_disk_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"security_group_names": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"vpc_security_group_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tag_specifications": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": tagsSchemaComputed(),
+					},
+				},
+			},
+			"user_data": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsLaunchTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	req := &ec2.DescribeLaunchTemplatesInput{}
+
+	if v, ok := d.GetOk("id"); ok {
+		req.LaunchTemplateIds = []*string{aws.String(v.(string))}
+	}
+	if v, ok := d.GetOk("name"); ok {
+		req.LaunchTemplateNames = []*string{aws.String(v.(string))}
+	}
+
+	req.Filters = buildEC2TagFilterList(
+		tagsFromMap(d.Get("tags").(map[string]interface{})),
+	)
+	req.Filters = append(req.Filters, buildEC2CustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+	if len(req.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		req.Filters = nil
+	}
+
+	log.Printf("[DEBUG] Reading launch template: %s", req)
+	resp, err := conn.DescribeLaunchTemplates(req)
+	if err != nil {
+		return fmt.Errorf("Error getting launch template: %s", err)
+	}
+	if resp == nil || len(resp.LaunchTemplates) == 0 {
+		return fmt.Errorf("no matching launch template found")
+	}
+	if len(resp.LaunchTemplates) > 1 {
+		return fmt.Errorf("multiple launch templates matched; use additional constraints to reduce matches to a single launch template")
+	}
+
+	lt := resp.LaunchTemplates[0]
+	d.SetId(aws.StringValue(lt.LaunchTemplateId))
+	d.Set("name", lt.LaunchTemplateName)
+	d.Set("latest_version", lt.LatestVersionNumber)
+	d.Set("default_version", lt.DefaultVersionNumber)
+	d.Set("tags", tagsToMap(lt.Tags))
+
+	version := d.Get("version").(string)
+	dltv, err := conn.DescribeLaunchTemplateVersions(&ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: lt.LaunchTemplateId,
+		Versions:         []*string{aws.String(version)},
+	})
+	if err != nil {
+		return fmt.Errorf("Error getting launch template %s version %q: %s", d.Id(), version, err)
+	}
+	if len(dltv.LaunchTemplateVersions) == 0 {
+		return fmt.Errorf("no version %q found for launch template %s", version, d.Id())
+	}
+
+	log.Printf("[DEBUG] Received launch template version %q (version %s)", d.Id(), version)
+
+	ltv := dltv.LaunchTemplateVersions[0]
+	ltData := ltv.LaunchTemplateData
+
+	d.Set("description", ltv.VersionDescription)
+	d.Set("disable_api_termination", ltData.DisableApiTermination)
+	d.Set("ebs_optimized", ltData.EbsOptimized)
+	d.Set("image_id", ltData.ImageId)
+	d.Set("instance_initiated_shutdown_behavior", ltData.InstanceInitiatedShutdownBehavior)
+	d.Set("instance_type", ltData.InstanceType)
+	d.Set("kernel_id", ltData.KernelId)
+	d.Set("key_name", ltData.KeyName)
+	d.Set("ram_disk_id", ltData.RamDiskId)
+	d.Set("security_group_names", aws.StringValueSlice(ltData.SecurityGroups))
+	d.Set("user_data", ltData.UserData)
+	d.Set("vpc_security_group_ids", aws.StringValueSlice(ltData.SecurityGroupIds))
+
+	if err := d.Set("block_device_mappings", getBlockDeviceMappings(ltData.BlockDeviceMappings)); err != nil {
+		return err
+	}
+
+	if err := d.Set("credit_specification", getCreditSpecification(ltData.CreditSpecification)); err != nil {
+		return err
+	}
+
+	if err := d.Set("elastic_gpu_specifications", getElasticGpuSpecifications(ltData.ElasticGpuSpecifications)); err != nil {
+		return err
+	}
+
+	if err := d.Set("iam_instance_profile", getIamInstanceProfile(ltData.IamInstanceProfile)); err != nil {
+		return err
+	}
+
+	if err := d.Set("instance_market_options", getInstanceMarketOptions(ltData.InstanceMarketOptions)); err != nil {
+		return err
+	}
+
+	if err := d.Set("monitoring", getMonitoring(ltData.Monitoring)); err != nil {
+		return err
+	}
+
+	if err := d.Set("network_interfaces", getNetworkInterfaces(ltData.NetworkInterfaces)); err != nil {
+		return err
+	}
+
+	if err := d.Set("placement", getPlacement(ltData.Placement)); err != nil {
+		return err
+	}
+
+	if err := d.Set("tag_specifications", getTagSpecifications(ltData.TagSpecifications)); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSLaunchTemplateDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_launch_template.test"
+	resourceName := "aws_launch_template.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLaunchTemplateDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", dataSourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "default_version", dataSourceName, "default_version"),
+					resource.TestCheckResourceAttrPair(resourceName, "latest_version", dataSourceName, "latest_version"),
+					resource.TestCheckResourceAttrPair(resourceName, "image_id", dataSourceName, "image_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_type", dataSourceName, "instance_type"),
+					resource.TestCheckResourceAttr(dataSourceName, "block_device_mappings.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "block_device_mappings.0.ebs.0.volume_size", "15"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLaunchTemplateDataSource_filter(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_launch_template.test"
+	resourceName := "aws_launch_template.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLaunchTemplateDataSourceConfig_filter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", dataSourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSLaunchTemplateDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %q
+  image_id      = "ami-12a3b456"
+  instance_type = "t2.micro"
+
+  block_device_mappings {
+    device_name = "/dev/sda1"
+
+    ebs {
+      volume_size = 15
+    }
+  }
+
+  tags {
+    Name = %q
+  }
+}
+
+data "aws_launch_template" "test" {
+  name = "${aws_launch_template.test.name}"
+}
+`, rName, rName)
+}
+
+func testAccAWSLaunchTemplateDataSourceConfig_filter(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name = %q
+}
+
+data "aws_launch_template" "test" {
+  filter {
+    name   = "launch-template-name"
+    values = ["${aws_launch_template.test.name}"]
+  }
+}
+`, rName)
+}
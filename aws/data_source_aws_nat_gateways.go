@@ -0,0 +1,92 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsNatGateways() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsNatGatewaysRead,
+		Schema: map[string]*schema.Schema{
+			"filter": ec2CustomFiltersSchema(),
+
+			"tags": tagsSchemaComputed(),
+
+			"state": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceAwsNatGatewaysRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	req := &ec2.DescribeNatGatewaysInput{}
+
+	req.Filter = buildEC2AttributeFilterList(
+		map[string]string{
+			"state":     d.Get("state").(string),
+			"subnet-id": d.Get("subnet_id").(string),
+			"vpc-id":    d.Get("vpc_id").(string),
+		},
+	)
+	req.Filter = append(req.Filter, buildEC2TagFilterList(
+		tagsFromMap(d.Get("tags").(map[string]interface{})),
+	)...)
+	req.Filter = append(req.Filter, buildEC2CustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+	if len(req.Filter) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		req.Filter = nil
+	}
+
+	log.Printf("[DEBUG] DescribeNatGateways %s\n", req)
+
+	natGateways := make([]string, 0)
+	err := conn.DescribeNatGatewaysPages(req, func(resp *ec2.DescribeNatGatewaysOutput, isLast bool) bool {
+		for _, ngw := range resp.NatGateways {
+			natGateways = append(natGateways, aws.StringValue(ngw.NatGatewayId))
+		}
+		return !isLast
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(natGateways) == 0 {
+		return fmt.Errorf("no matching NAT gateways found")
+	}
+
+	d.SetId(resource.UniqueId())
+	if err := d.Set("ids", natGateways); err != nil {
+		return fmt.Errorf("Error setting NAT gateway ids: %s", err)
+	}
+
+	return nil
+}
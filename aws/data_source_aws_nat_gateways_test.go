@@ -0,0 +1,74 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsNatGateways_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsNatGatewaysConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_nat_gateways.by_vpc", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_nat_gateways.by_subnet", "ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAwsNatGatewaysConfig = `
+resource "aws_vpc" "test" {
+  cidr_block = "10.3.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-nat-gateways-data-source"
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = "${aws_vpc.test.id}"
+
+  tags {
+    Name = "terraform-testacc-nat-gateways-data-source"
+  }
+}
+
+resource "aws_subnet" "test" {
+  count      = 2
+  vpc_id     = "${aws_vpc.test.id}"
+  cidr_block = "10.3.${count.index}.0/24"
+
+  tags {
+    Name = "tf-acc-nat-gateways-data-source"
+  }
+}
+
+resource "aws_eip" "test" {
+  count = 2
+  vpc   = true
+}
+
+resource "aws_nat_gateway" "test" {
+  count         = 2
+  allocation_id = "${element(aws_eip.test.*.id, count.index)}"
+  subnet_id     = "${element(aws_subnet.test.*.id, count.index)}"
+
+  depends_on = ["aws_internet_gateway.test"]
+}
+
+data "aws_nat_gateways" "by_vpc" {
+  vpc_id = "${aws_vpc.test.id}"
+
+  depends_on = ["aws_nat_gateway.test"]
+}
+
+data "aws_nat_gateways" "by_subnet" {
+  subnet_id = "${aws_nat_gateway.test.0.subnet_id}"
+}
+`
@@ -0,0 +1,56 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsNetworkAcls_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsNetworkAclsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					// The default network ACL plus the one created below.
+					resource.TestCheckResourceAttr("data.aws_network_acls.selected", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_network_acls.tagged", "ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAwsNetworkAclsConfig = `
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-network-acls-data-source"
+  }
+}
+
+resource "aws_network_acl" "test" {
+  vpc_id = "${aws_vpc.test.id}"
+
+  tags {
+    Name = "tf-acc-network-acls-data-source"
+  }
+}
+
+data "aws_network_acls" "selected" {
+  vpc_id = "${aws_vpc.test.id}"
+
+  depends_on = ["aws_network_acl.test"]
+}
+
+data "aws_network_acls" "tagged" {
+  vpc_id = "${aws_vpc.test.id}"
+
+  tags {
+    Name = "${aws_network_acl.test.tags["Name"]}"
+  }
+}
+`
@@ -0,0 +1,69 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsNetworkInterfaces_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsNetworkInterfacesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_network_interfaces.filtered", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_network_interfaces.tagged", "ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAwsNetworkInterfacesConfig = `
+resource "aws_vpc" "test" {
+  cidr_block = "10.2.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-network-interfaces-data-source"
+  }
+}
+
+resource "aws_subnet" "test" {
+  vpc_id     = "${aws_vpc.test.id}"
+  cidr_block = "10.2.1.0/24"
+
+  tags {
+    Name = "tf-acc-network-interfaces-data-source"
+  }
+}
+
+resource "aws_network_interface" "first" {
+  subnet_id = "${aws_subnet.test.id}"
+}
+
+resource "aws_network_interface" "second" {
+  subnet_id = "${aws_subnet.test.id}"
+
+  tags {
+    Name = "tf-acc-network-interfaces-data-source-second"
+  }
+}
+
+data "aws_network_interfaces" "filtered" {
+  filter {
+    name   = "subnet-id"
+    values = ["${aws_subnet.test.id}"]
+  }
+
+  depends_on = ["aws_network_interface.first", "aws_network_interface.second"]
+}
+
+data "aws_network_interfaces" "tagged" {
+  tags {
+    Name = "${aws_network_interface.second.tags["Name"]}"
+  }
+}
+`
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/redshift"
@@ -91,6 +92,60 @@ func dataSourceAwsRedshiftCluster() *schema.Resource {
 				Computed: true,
 			},
 
+			"logging": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"bucket_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"s3_key_prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_successful_delivery_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_failure_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_failure_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"snapshot_copy": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"retention_period": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"grant_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"encrypted": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -248,6 +303,9 @@ func dataSourceAwsRedshiftClusterRead(d *schema.ResourceData, meta interface{})
 	d.Set("port", rsc.Endpoint.Port)
 	d.Set("preferred_maintenance_window", rsc.PreferredMaintenanceWindow)
 	d.Set("publicly_accessible", rsc.PubliclyAccessible)
+	if err := d.Set("snapshot_copy", flattenRedshiftSnapshotCopy(rsc.ClusterSnapshotCopyStatus)); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Snapshot Copy status to state for Redshift Cluster (%s): %s", cluster, err)
+	}
 	d.Set("tags", tagsToMapRedshift(rsc.Tags))
 	d.Set("vpc_id", rsc.VpcId)
 
@@ -274,5 +332,30 @@ func dataSourceAwsRedshiftClusterRead(d *schema.ResourceData, meta interface{})
 		d.Set("s3_key_prefix", loggingStatus.S3KeyPrefix)
 	}
 
+	if err := d.Set("logging", flattenRedshiftLoggingStatus(loggingStatus)); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Logging status to state for Redshift Cluster (%s): %s", cluster, err)
+	}
+
 	return nil
 }
+
+func flattenRedshiftLoggingStatus(ls *redshift.LoggingStatus) []interface{} {
+	if ls == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"enable":               aws.BoolValue(ls.LoggingEnabled),
+		"bucket_name":          aws.StringValue(ls.BucketName),
+		"s3_key_prefix":        aws.StringValue(ls.S3KeyPrefix),
+		"last_failure_message": aws.StringValue(ls.LastFailureMessage),
+	}
+	if ls.LastSuccessfulDeliveryTime != nil {
+		m["last_successful_delivery_time"] = ls.LastSuccessfulDeliveryTime.Format(time.RFC3339)
+	}
+	if ls.LastFailureTime != nil {
+		m["last_failure_time"] = ls.LastFailureTime.Format(time.RFC3339)
+	}
+
+	return []interface{}{m}
+}
@@ -35,6 +35,9 @@ func TestAccAWSDataSourceRedshiftCluster_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.aws_redshift_cluster.test", "port"),
 					resource.TestCheckResourceAttrSet("data.aws_redshift_cluster.test", "preferred_maintenance_window"),
 					resource.TestCheckResourceAttrSet("data.aws_redshift_cluster.test", "publicly_accessible"),
+					resource.TestCheckResourceAttr("data.aws_redshift_cluster.test", "logging.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_redshift_cluster.test", "logging.0.enable", "false"),
+					resource.TestCheckResourceAttr("data.aws_redshift_cluster.test", "snapshot_copy.#", "0"),
 				),
 			},
 		},
@@ -0,0 +1,66 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsRouteTables_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsRouteTablesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					// The main route table plus the two created below.
+					resource.TestCheckResourceAttr("data.aws_route_tables.selected", "ids.#", "3"),
+					resource.TestCheckResourceAttr("data.aws_route_tables.private", "ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAwsRouteTablesConfig = `
+resource "aws_vpc" "test" {
+  cidr_block = "172.16.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-route-tables-data-source"
+  }
+}
+
+resource "aws_route_table" "public" {
+  vpc_id = "${aws_vpc.test.id}"
+
+  tags {
+    Name = "tf-acc-route-tables-data-source-public"
+    Tier = "Public"
+  }
+}
+
+resource "aws_route_table" "private" {
+  vpc_id = "${aws_vpc.test.id}"
+
+  tags {
+    Name = "tf-acc-route-tables-data-source-private"
+    Tier = "Private"
+  }
+}
+
+data "aws_route_tables" "selected" {
+  vpc_id = "${aws_vpc.test.id}"
+
+  depends_on = ["aws_route_table.public", "aws_route_table.private"]
+}
+
+data "aws_route_tables" "private" {
+  vpc_id = "${aws_vpc.test.id}"
+
+  tags {
+    Tier = "${aws_route_table.private.tags["Tier"]}"
+  }
+}
+`
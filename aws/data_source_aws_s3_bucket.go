@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
 )
 
 func dataSourceAwsS3Bucket() *schema.Resource {
@@ -43,6 +44,172 @@ func dataSourceAwsS3Bucket() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"versioning": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"mfa_delete": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"logging": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_bucket": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"lifecycle_rule": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": tagsSchemaComputed(),
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"abort_incomplete_multipart_upload_days": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"expiration": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Set:      expirationHash,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"days": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"expired_object_delete_marker": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"noncurrent_version_expiration": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Set:      expirationHash,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"transition": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Set:      transitionHash,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"days": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"storage_class": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"noncurrent_version_transition": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Set:      transitionHash,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"storage_class": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"server_side_encryption_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"apply_server_side_encryption_by_default": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"kms_master_key_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"sse_algorithm": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags": tagsSchemaComputed(),
 		},
 	}
 }
@@ -76,6 +243,10 @@ func dataSourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if err := bucketConfiguration(d, bucket, conn); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -121,3 +292,113 @@ func bucketLocation(d *schema.ResourceData, bucket string, conn *s3.S3) error {
 	}
 	return nil
 }
+
+// bucketConfiguration reads the bucket sub-configurations (policy, versioning,
+// logging, lifecycle, default encryption and tags) exported by the data source.
+// A sub-configuration the caller is not allowed to read is left empty, so the
+// data source keeps working with the HeadBucket and GetBucketLocation
+// permissions it originally required.
+func bucketConfiguration(d *schema.ResourceData, bucket string, conn *s3.S3) error {
+	policy := ""
+	pol, err := conn.GetBucketPolicy(&s3.GetBucketPolicyInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		if !isAWSErr(err, "NoSuchBucketPolicy", "") && !isS3BucketConfigurationAccessDenied(err, bucket, "policy") {
+			return fmt.Errorf("error reading S3 bucket (%s) policy: %s", bucket, err)
+		}
+	} else if pol.Policy != nil {
+		policy, err = structure.NormalizeJsonString(aws.StringValue(pol.Policy))
+		if err != nil {
+			return fmt.Errorf("policy contains an invalid JSON: %s", err)
+		}
+	}
+	d.Set("policy", policy)
+
+	versioning := make([]map[string]interface{}, 0)
+	versioningOutput, err := conn.GetBucketVersioning(&s3.GetBucketVersioningInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		if !isS3BucketConfigurationAccessDenied(err, bucket, "versioning") {
+			return fmt.Errorf("error reading S3 bucket (%s) versioning: %s", bucket, err)
+		}
+	} else {
+		versioning = flattenAwsS3BucketVersioning(versioningOutput)
+	}
+	if err := d.Set("versioning", versioning); err != nil {
+		return err
+	}
+
+	logging := make([]map[string]interface{}, 0)
+	loggingOutput, err := conn.GetBucketLogging(&s3.GetBucketLoggingInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		if !isS3BucketConfigurationAccessDenied(err, bucket, "logging") {
+			return fmt.Errorf("error reading S3 bucket (%s) logging: %s", bucket, err)
+		}
+	} else {
+		logging = flattenAwsS3BucketLogging(loggingOutput.LoggingEnabled)
+	}
+	if err := d.Set("logging", logging); err != nil {
+		return err
+	}
+
+	rules := make([]map[string]interface{}, 0)
+	lifecycle, err := conn.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		if !isAWSErr(err, "NoSuchLifecycleConfiguration", "") && !isS3BucketConfigurationAccessDenied(err, bucket, "lifecycle configuration") {
+			return fmt.Errorf("error reading S3 bucket (%s) lifecycle configuration: %s", bucket, err)
+		}
+	} else {
+		rules = flattenAwsS3BucketLifecycleRules(lifecycle.Rules)
+	}
+	if err := d.Set("lifecycle_rule", rules); err != nil {
+		return err
+	}
+
+	encryptionConfiguration := make([]map[string]interface{}, 0)
+	encryption, err := conn.GetBucketEncryption(&s3.GetBucketEncryptionInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		if !isAWSErr(err, "ServerSideEncryptionConfigurationNotFoundError", "encryption configuration was not found") &&
+			!isS3BucketConfigurationAccessDenied(err, bucket, "encryption configuration") {
+			return fmt.Errorf("error reading S3 bucket (%s) encryption configuration: %s", bucket, err)
+		}
+	} else if c := encryption.ServerSideEncryptionConfiguration; c != nil {
+		encryptionConfiguration = flattenAwsS3ServerSideEncryptionConfiguration(c)
+	}
+	if err := d.Set("server_side_encryption_configuration", encryptionConfiguration); err != nil {
+		return err
+	}
+
+	tags := make(map[string]string)
+	tagSet, err := getTagSetS3(conn, bucket)
+	if err != nil {
+		if !isS3BucketConfigurationAccessDenied(err, bucket, "tags") {
+			return fmt.Errorf("error reading S3 bucket (%s) tags: %s", bucket, err)
+		}
+	} else {
+		tags = tagsToMapS3(tagSet)
+	}
+	if err := d.Set("tags", tags); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// isS3BucketConfigurationAccessDenied reports whether reading a bucket
+// sub-configuration failed because of missing permissions.
+func isS3BucketConfigurationAccessDenied(err error, bucket, configuration string) bool {
+	if !isAWSErr(err, "AccessDenied", "") {
+		return false
+	}
+
+	log.Printf("[WARN] Access denied reading S3 bucket (%s) %s, leaving it empty: %s", bucket, configuration, err)
+	return true
+}
@@ -0,0 +1,159 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAWSS3BucketObjects_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigBasic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.0", "arch/navajo/north_window"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.1", "arch/navajo/sand_dune"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "owners.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAWSS3BucketObjects_delimiter(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigDelimiter(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.#", "0"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "common_prefixes.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "common_prefixes.0", "arch/courthouse_towers/"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "common_prefixes.1", "arch/navajo/"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAWSS3BucketObjects_maxKeysAndOwners(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigMaxKeysAndOwners(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "owners.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSDataSourceS3ObjectsConfigResources(randInt int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "objects_bucket" {
+  bucket = "tf-objects-test-bucket-%d"
+}
+
+resource "aws_s3_bucket_object" "object1" {
+  bucket  = "${aws_s3_bucket.objects_bucket.id}"
+  key     = "arch/three_gossips/turret"
+  content = "Delicate"
+}
+
+resource "aws_s3_bucket_object" "object2" {
+  bucket  = "${aws_s3_bucket.objects_bucket.id}"
+  key     = "arch/three_gossips/sentinel"
+  content = "Balanced"
+}
+
+resource "aws_s3_bucket_object" "object3" {
+  bucket  = "${aws_s3_bucket.objects_bucket.id}"
+  key     = "arch/navajo/north_window"
+  content = "Landscape"
+}
+
+resource "aws_s3_bucket_object" "object4" {
+  bucket  = "${aws_s3_bucket.objects_bucket.id}"
+  key     = "arch/navajo/sand_dune"
+  content = "Double"
+}
+
+resource "aws_s3_bucket_object" "object5" {
+  bucket  = "${aws_s3_bucket.objects_bucket.id}"
+  key     = "arch/partition/park_avenue"
+  content = "Double"
+}
+
+resource "aws_s3_bucket_object" "object6" {
+  bucket  = "${aws_s3_bucket.objects_bucket.id}"
+  key     = "arch/courthouse_towers/landscape"
+  content = "Fiery"
+}
+
+resource "aws_s3_bucket_object" "object7" {
+  bucket  = "${aws_s3_bucket.objects_bucket.id}"
+  key     = "arch/rubicon"
+  content = "Devils-Garden"
+}
+`, randInt)
+}
+
+func testAccAWSDataSourceS3ObjectsConfigBasic(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_bucket_objects" "yesh" {
+  bucket     = "${aws_s3_bucket.objects_bucket.id}"
+  prefix     = "arch/navajo/"
+  delimiter  = "/"
+  depends_on = ["aws_s3_bucket_object.object1", "aws_s3_bucket_object.object2", "aws_s3_bucket_object.object3", "aws_s3_bucket_object.object4", "aws_s3_bucket_object.object5", "aws_s3_bucket_object.object6", "aws_s3_bucket_object.object7"]
+}
+`, testAccAWSDataSourceS3ObjectsConfigResources(randInt))
+}
+
+func testAccAWSDataSourceS3ObjectsConfigDelimiter(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_bucket_objects" "yesh" {
+  bucket      = "${aws_s3_bucket.objects_bucket.id}"
+  prefix      = "arch/"
+  delimiter   = "/"
+  start_after = "arch/a"
+  max_keys    = 2
+  depends_on  = ["aws_s3_bucket_object.object1", "aws_s3_bucket_object.object2", "aws_s3_bucket_object.object3", "aws_s3_bucket_object.object4", "aws_s3_bucket_object.object5", "aws_s3_bucket_object.object6", "aws_s3_bucket_object.object7"]
+}
+`, testAccAWSDataSourceS3ObjectsConfigResources(randInt))
+}
+
+func testAccAWSDataSourceS3ObjectsConfigMaxKeysAndOwners(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_bucket_objects" "yesh" {
+  bucket      = "${aws_s3_bucket.objects_bucket.id}"
+  prefix      = "arch/"
+  max_keys    = 2
+  fetch_owner = true
+  depends_on  = ["aws_s3_bucket_object.object1", "aws_s3_bucket_object.object2", "aws_s3_bucket_object.object3", "aws_s3_bucket_object.object4", "aws_s3_bucket_object.object5", "aws_s3_bucket_object.object6", "aws_s3_bucket_object.object7"]
+}
+`, testAccAWSDataSourceS3ObjectsConfigResources(randInt))
+}
@@ -5,8 +5,11 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
 func TestAccDataSourceS3Bucket_basic(t *testing.T) {
@@ -58,6 +61,42 @@ func TestAccDataSourceS3Bucket_website(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceS3Bucket_configuration(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceS3BucketConfigurationConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExists("data.aws_s3_bucket.bucket"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket.bucket", "versioning.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket.bucket", "versioning.0.enabled", "true"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket.bucket", "versioning.0.mfa_delete", "false"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket.bucket", "logging.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_s3_bucket.bucket", "logging.0.target_bucket",
+						"aws_s3_bucket.log_bucket", "id"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket.bucket", "logging.0.target_prefix", "log/"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket.bucket", "lifecycle_rule.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket.bucket", "lifecycle_rule.0.id", "tmp"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket.bucket", "lifecycle_rule.0.prefix", "tmp/"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket.bucket", "lifecycle_rule.0.enabled", "true"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket.bucket", "lifecycle_rule.0.expiration.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket.bucket", "server_side_encryption_configuration.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.aws_s3_bucket.bucket", "server_side_encryption_configuration.0.rule.0.apply_server_side_encryption_by_default.0.sse_algorithm", "AES256"),
+					resource.TestMatchResourceAttr("data.aws_s3_bucket.bucket", "policy", regexp.MustCompile("DenyInsecureTransport")),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket.bucket", "tags.%", "1"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket.bucket", "tags.Name", fmt.Sprintf("tf-test-bucket-%d", rInt)),
+				),
+			},
+		},
+	})
+}
+
 func testAccAWSDataSourceS3BucketConfig_basic(randInt int) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "bucket" {
@@ -85,3 +124,160 @@ data "aws_s3_bucket" "bucket" {
 	bucket = "${aws_s3_bucket.bucket.id}"
 }`, randInt)
 }
+
+func testAccAWSDataSourceS3BucketConfigurationConfig(randInt int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "log_bucket" {
+	bucket = "tf-test-log-bucket-%d"
+	acl = "log-delivery-write"
+	force_destroy = true
+}
+
+resource "aws_s3_bucket" "bucket" {
+	bucket = "tf-test-bucket-%d"
+
+	versioning {
+		enabled = true
+	}
+
+	logging {
+		target_bucket = "${aws_s3_bucket.log_bucket.id}"
+		target_prefix = "log/"
+	}
+
+	lifecycle_rule {
+		id = "tmp"
+		prefix = "tmp/"
+		enabled = true
+
+		expiration {
+			days = 30
+		}
+	}
+
+	server_side_encryption_configuration {
+		rule {
+			apply_server_side_encryption_by_default {
+				sse_algorithm = "AES256"
+			}
+		}
+	}
+
+	tags {
+		Name = "tf-test-bucket-%d"
+	}
+}
+
+resource "aws_s3_bucket_policy" "bucket" {
+	bucket = "${aws_s3_bucket.bucket.id}"
+	policy = <<POLICY
+{
+	"Version": "2012-10-17",
+	"Statement": [
+		{
+			"Sid": "DenyInsecureTransport",
+			"Effect": "Deny",
+			"Principal": "*",
+			"Action": "s3:*",
+			"Resource": "${aws_s3_bucket.bucket.arn}/*",
+			"Condition": {
+				"Bool": {
+					"aws:SecureTransport": "false"
+				}
+			}
+		}
+	]
+}
+POLICY
+}
+
+data "aws_s3_bucket" "bucket" {
+	bucket = "${aws_s3_bucket_policy.bucket.bucket}"
+}`, randInt, randInt, randInt)
+}
+
+func TestS3BucketConfiguration_accessDenied(t *testing.T) {
+	accessDenied := &awsMockResponse{403, test_s3_accessDenied_response, "application/xml"}
+	s3Endpoints := []*awsMockEndpoint{
+		&awsMockEndpoint{
+			Request:  &awsMockRequest{"GET", "/tf-test-bucket?policy=", ""},
+			Response: accessDenied,
+		},
+		&awsMockEndpoint{
+			Request:  &awsMockRequest{"GET", "/tf-test-bucket?versioning=", ""},
+			Response: accessDenied,
+		},
+		&awsMockEndpoint{
+			Request:  &awsMockRequest{"GET", "/tf-test-bucket?logging=", ""},
+			Response: accessDenied,
+		},
+		&awsMockEndpoint{
+			Request:  &awsMockRequest{"GET", "/tf-test-bucket?lifecycle=", ""},
+			Response: accessDenied,
+		},
+		&awsMockEndpoint{
+			Request:  &awsMockRequest{"GET", "/tf-test-bucket?encryption=", ""},
+			Response: accessDenied,
+		},
+		&awsMockEndpoint{
+			Request:  &awsMockRequest{"GET", "/tf-test-bucket?tagging=", ""},
+			Response: accessDenied,
+		},
+	}
+	closeFunc, sess, err := getMockedAwsApiSession("S3", s3Endpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeFunc()
+	conn := s3.New(sess, &aws.Config{S3ForcePathStyle: aws.Bool(true)})
+
+	d := schema.TestResourceDataRaw(t, dataSourceAwsS3Bucket().Schema, map[string]interface{}{
+		"bucket": "tf-test-bucket",
+	})
+	if err := bucketConfiguration(d, "tf-test-bucket", conn); err != nil {
+		t.Fatalf("expected access denied errors to be ignored, got: %s", err)
+	}
+
+	if v := d.Get("policy").(string); v != "" {
+		t.Fatalf("expected empty policy, got %q", v)
+	}
+	for _, k := range []string{"versioning", "logging", "lifecycle_rule", "server_side_encryption_configuration"} {
+		if v := d.Get(k).([]interface{}); len(v) != 0 {
+			t.Fatalf("expected empty %s, got %#v", k, v)
+		}
+	}
+	if v := d.Get("tags").(map[string]interface{}); len(v) != 0 {
+		t.Fatalf("expected empty tags, got %#v", v)
+	}
+}
+
+func TestS3BucketConfiguration_error(t *testing.T) {
+	s3Endpoints := []*awsMockEndpoint{
+		&awsMockEndpoint{
+			Request:  &awsMockRequest{"GET", "/tf-test-bucket?policy=", ""},
+			Response: &awsMockResponse{500, test_s3_internalError_response, "application/xml"},
+		},
+	}
+	closeFunc, sess, err := getMockedAwsApiSession("S3", s3Endpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeFunc()
+	conn := s3.New(sess, &aws.Config{
+		S3ForcePathStyle: aws.Bool(true),
+		MaxRetries:       aws.Int(0),
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceAwsS3Bucket().Schema, map[string]interface{}{
+		"bucket": "tf-test-bucket",
+	})
+	if err := bucketConfiguration(d, "tf-test-bucket", conn); err == nil {
+		t.Fatal("expected errors other than access denied to be returned")
+	}
+}
+
+const test_s3_accessDenied_response = `<?xml version="1.0" encoding="UTF-8"?>
+<Error><Code>AccessDenied</Code><Message>Access Denied</Message><RequestId>4442587FB7D0A2F9</RequestId></Error>`
+
+const test_s3_internalError_response = `<?xml version="1.0" encoding="UTF-8"?>
+<Error><Code>InternalError</Code><Message>We encountered an internal error. Please try again.</Message><RequestId>4442587FB7D0A2F9</RequestId></Error>`
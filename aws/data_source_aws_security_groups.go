@@ -0,0 +1,91 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsSecurityGroups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsSecurityGroupsRead,
+		Schema: map[string]*schema.Schema{
+			"filter": ec2CustomFiltersSchema(),
+
+			"tags": tagsSchemaComputed(),
+
+			"ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"vpc_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceAwsSecurityGroupsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	req := &ec2.DescribeSecurityGroupsInput{}
+
+	req.Filters = buildEC2TagFilterList(
+		tagsFromMap(d.Get("tags").(map[string]interface{})),
+	)
+	req.Filters = append(req.Filters, buildEC2CustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+	if len(req.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		req.Filters = nil
+	}
+
+	log.Printf("[DEBUG] DescribeSecurityGroups %s\n", req)
+
+	ids := make([]string, 0)
+	vpcIds := make([]string, 0)
+	for {
+		resp, err := conn.DescribeSecurityGroups(req)
+		if err != nil {
+			return err
+		}
+
+		for _, sg := range resp.SecurityGroups {
+			ids = append(ids, aws.StringValue(sg.GroupId))
+			vpcIds = append(vpcIds, aws.StringValue(sg.VpcId))
+		}
+
+		if resp.NextToken == nil {
+			break
+		}
+		req.NextToken = resp.NextToken
+	}
+
+	if len(ids) == 0 {
+		return fmt.Errorf("no matching security groups found")
+	}
+
+	log.Printf("[DEBUG] Found %d security groups via given filter", len(ids))
+
+	d.SetId(resource.UniqueId())
+	if err := d.Set("ids", ids); err != nil {
+		return fmt.Errorf("Error setting security group ids: %s", err)
+	}
+
+	if err := d.Set("vpc_ids", vpcIds); err != nil {
+		return fmt.Errorf("Error setting security group vpc ids: %s", err)
+	}
+
+	return nil
+}
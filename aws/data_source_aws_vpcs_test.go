@@ -0,0 +1,52 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsVpcs_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsVpcsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_vpcs.selected", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_vpcs.filtered", "ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsVpcsConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/24"
+
+  tags {
+    Name    = "terraform-testacc-vpcs-data-source"
+    Service = "testacc-vpcs-%s"
+  }
+}
+
+data "aws_vpcs" "selected" {
+  tags {
+    Service = "${aws_vpc.test.tags["Service"]}"
+  }
+}
+
+data "aws_vpcs" "filtered" {
+  filter {
+    name   = "vpc-id"
+    values = ["${aws_vpc.test.id}"]
+  }
+}
+`, rName)
+}
@@ -0,0 +1,182 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsVpnConnection() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsVpnConnectionRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"customer_gateway_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"vpn_gateway_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"filter": ec2CustomFiltersSchema(),
+			"tags":   tagsSchemaComputed(),
+
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"static_routes_only": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"customer_gateway_configuration": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"tunnels": vpnConnectionTunnelsSchema(),
+			"routes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"vgw_telemetry": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accepted_route_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"last_status_change": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"outside_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsVpnConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	req := &ec2.DescribeVpnConnectionsInput{}
+
+	if id, ok := d.GetOk("id"); ok {
+		req.VpnConnectionIds = aws.StringSlice([]string{id.(string)})
+	}
+
+	req.Filters = buildEC2AttributeFilterList(
+		map[string]string{
+			"state":               d.Get("state").(string),
+			"customer-gateway-id": d.Get("customer_gateway_id").(string),
+			"vpn-gateway-id":      d.Get("vpn_gateway_id").(string),
+		},
+	)
+	req.Filters = append(req.Filters, buildEC2TagFilterList(
+		tagsFromMap(d.Get("tags").(map[string]interface{})),
+	)...)
+	req.Filters = append(req.Filters, buildEC2CustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+	if len(req.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		req.Filters = nil
+	}
+
+	log.Printf("[DEBUG] Reading VPN Connection: %s", req)
+	resp, err := conn.DescribeVpnConnections(req)
+	if err != nil {
+		return err
+	}
+	if resp == nil || len(resp.VpnConnections) == 0 {
+		return fmt.Errorf("no matching VPN connection found: %#v", req)
+	}
+	if len(resp.VpnConnections) > 1 {
+		return fmt.Errorf("multiple VPN connections matched; use additional constraints to reduce matches to a single VPN connection")
+	}
+
+	vpnConnection := resp.VpnConnections[0]
+
+	d.SetId(aws.StringValue(vpnConnection.VpnConnectionId))
+	d.Set("state", vpnConnection.State)
+	d.Set("customer_gateway_id", vpnConnection.CustomerGatewayId)
+	d.Set("vpn_gateway_id", vpnConnection.VpnGatewayId)
+	d.Set("type", vpnConnection.Type)
+	d.Set("tags", tagsToMap(vpnConnection.Tags))
+
+	if vpnConnection.Options != nil {
+		d.Set("static_routes_only", vpnConnection.Options.StaticRoutesOnly)
+	} else {
+		d.Set("static_routes_only", false)
+	}
+
+	d.Set("customer_gateway_configuration", vpnConnection.CustomerGatewayConfiguration)
+
+	tunnels := make([]map[string]interface{}, 0)
+	if vpnConnection.CustomerGatewayConfiguration != nil {
+		vpnConfig, err := xmlConfigToVpnConnectionConfig(*vpnConnection.CustomerGatewayConfiguration)
+		if err != nil {
+			return fmt.Errorf("error parsing customer gateway configuration for VPN connection (%s): %s", d.Id(), err)
+		}
+		tunnels = flattenVpnConnectionTunnels(vpnConfig)
+	}
+	if err := d.Set("tunnels", tunnels); err != nil {
+		return err
+	}
+
+	if err := d.Set("vgw_telemetry", telemetryToMapList(vpnConnection.VgwTelemetry)); err != nil {
+		return err
+	}
+	if err := d.Set("routes", routesToMapList(vpnConnection.Routes)); err != nil {
+		return err
+	}
+
+	return nil
+}
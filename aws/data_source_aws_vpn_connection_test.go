@@ -0,0 +1,91 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsVpnConnection_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	rBgpAsn := acctest.RandIntRange(64512, 65534)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceAwsVpnConnectionConfig(rInt, rBgpAsn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.aws_vpn_connection.test_by_id", "id",
+						"aws_vpn_connection.test", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_vpn_connection.test_by_tags", "id",
+						"aws_vpn_connection.test", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_vpn_connection.test_by_id", "customer_gateway_id",
+						"aws_customer_gateway.test", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_vpn_connection.test_by_id", "vpn_gateway_id",
+						"aws_vpn_gateway.test", "id"),
+					resource.TestCheckResourceAttr("data.aws_vpn_connection.test_by_id", "state", "available"),
+					resource.TestCheckResourceAttr("data.aws_vpn_connection.test_by_id", "type", "ipsec.1"),
+					resource.TestCheckResourceAttr("data.aws_vpn_connection.test_by_id", "static_routes_only", "true"),
+					resource.TestCheckResourceAttr("data.aws_vpn_connection.test_by_id", "tunnels.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_vpn_connection.test_by_id", "tunnels.0.outside_address",
+						"aws_vpn_connection.test", "tunnel1_address"),
+					resource.TestCheckResourceAttr("data.aws_vpn_connection.test_by_id", "tunnels.0.inside_cidr", "169.254.12.0/30"),
+					resource.TestCheckResourceAttr("data.aws_vpn_connection.test_by_id", "tunnels.0.vgw_inside_address", "169.254.12.1"),
+					resource.TestCheckResourceAttr("data.aws_vpn_connection.test_by_id", "tunnels.0.cgw_inside_address", "169.254.12.2"),
+					resource.TestCheckResourceAttrSet("data.aws_vpn_connection.test_by_id", "tunnels.0.ike.0.lifetime"),
+					resource.TestCheckResourceAttrSet("data.aws_vpn_connection.test_by_id", "tunnels.0.ipsec.0.lifetime"),
+					resource.TestCheckResourceAttr("data.aws_vpn_connection.test_by_tags", "tags.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsVpnConnectionConfig(rInt, rBgpAsn int) string {
+	return fmt.Sprintf(`
+resource "aws_vpn_gateway" "test" {
+  tags {
+    Name = "terraform-testacc-vpn-connection-data-source-%d"
+  }
+}
+
+resource "aws_customer_gateway" "test" {
+  bgp_asn = %d
+  ip_address = "178.0.0.1"
+  type = "ipsec.1"
+  tags {
+    Name = "terraform-testacc-vpn-connection-data-source-%d"
+  }
+}
+
+resource "aws_vpn_connection" "test" {
+  vpn_gateway_id = "${aws_vpn_gateway.test.id}"
+  customer_gateway_id = "${aws_customer_gateway.test.id}"
+  type = "ipsec.1"
+  static_routes_only = true
+
+  tunnel1_inside_cidr = "169.254.12.0/30"
+
+  tags {
+    Name = "terraform-testacc-vpn-connection-data-source-%d"
+  }
+}
+
+data "aws_vpn_connection" "test_by_id" {
+  id = "${aws_vpn_connection.test.id}"
+}
+
+data "aws_vpn_connection" "test_by_tags" {
+  tags = "${aws_vpn_connection.test.tags}"
+}
+`, rInt, rBgpAsn, rInt, rInt)
+}
@@ -0,0 +1,578 @@
+package aws
+
+import (
+	"strings"
+)
+
+// iamPolicyServicePrefixes is the set of service prefixes that may appear in
+// the Action and NotAction elements of an IAM policy, and as the prefix of
+// service-specific condition keys.
+var iamPolicyServicePrefixes = iamPolicyCatalogSet(
+	"a4b",
+	"access-analyzer",
+	"acm",
+	"acm-pca",
+	"apigateway",
+	"application-autoscaling",
+	"applicationinsights",
+	"appmesh",
+	"appstream",
+	"appsync",
+	"artifact",
+	"athena",
+	"autoscaling",
+	"autoscaling-plans",
+	"aws-marketplace",
+	"aws-marketplace-management",
+	"aws-portal",
+	"awsconnector",
+	"backup",
+	"batch",
+	"budgets",
+	"ce",
+	"chime",
+	"cloud9",
+	"clouddirectory",
+	"cloudformation",
+	"cloudfront",
+	"cloudhsm",
+	"cloudsearch",
+	"cloudtrail",
+	"cloudwatch",
+	"codebuild",
+	"codecommit",
+	"codedeploy",
+	"codepipeline",
+	"codestar",
+	"cognito-identity",
+	"cognito-idp",
+	"cognito-sync",
+	"comprehend",
+	"config",
+	"connect",
+	"cur",
+	"datapipeline",
+	"datasync",
+	"dax",
+	"devicefarm",
+	"directconnect",
+	"discovery",
+	"dlm",
+	"dms",
+	"ds",
+	"dynamodb",
+	"ec2",
+	"ec2messages",
+	"ecr",
+	"ecs",
+	"eks",
+	"elasticache",
+	"elasticbeanstalk",
+	"elasticfilesystem",
+	"elasticloadbalancing",
+	"elasticmapreduce",
+	"elastictranscoder",
+	"es",
+	"events",
+	"execute-api",
+	"firehose",
+	"fms",
+	"fsx",
+	"gamelift",
+	"glacier",
+	"globalaccelerator",
+	"glue",
+	"greengrass",
+	"guardduty",
+	"health",
+	"iam",
+	"importexport",
+	"inspector",
+	"iot",
+	"iotanalytics",
+	"kafka",
+	"kinesis",
+	"kinesisanalytics",
+	"kinesisvideo",
+	"kms",
+	"lambda",
+	"lex",
+	"license-manager",
+	"lightsail",
+	"logs",
+	"machinelearning",
+	"macie",
+	"mediaconnect",
+	"mediaconvert",
+	"medialive",
+	"mediapackage",
+	"mediastore",
+	"mediatailor",
+	"mgh",
+	"mobileanalytics",
+	"mobilehub",
+	"mobiletargeting",
+	"mq",
+	"neptune-db",
+	"opsworks",
+	"opsworks-cm",
+	"organizations",
+	"pi",
+	"polly",
+	"pricing",
+	"quicksight",
+	"ram",
+	"rds",
+	"rds-data",
+	"rds-db",
+	"redshift",
+	"rekognition",
+	"resource-groups",
+	"robomaker",
+	"route53",
+	"route53domains",
+	"route53resolver",
+	"s3",
+	"sagemaker",
+	"sdb",
+	"secretsmanager",
+	"securityhub",
+	"serverlessrepo",
+	"servicecatalog",
+	"servicediscovery",
+	"ses",
+	"shield",
+	"signer",
+	"sms",
+	"sms-voice",
+	"snowball",
+	"sns",
+	"sqs",
+	"ssm",
+	"ssmmessages",
+	"sso",
+	"sso-directory",
+	"states",
+	"storagegateway",
+	"sts",
+	"sumerian",
+	"support",
+	"swf",
+	"tag",
+	"transcribe",
+	"transfer",
+	"translate",
+	"trustedadvisor",
+	"waf",
+	"waf-regional",
+	"workdocs",
+	"worklink",
+	"workmail",
+	"workspaces",
+	"xray",
+)
+
+// iamPolicyServiceActions lists the actions of services whose action set is
+// catalogued in full. Services that are not listed here are only checked for
+// a known service prefix.
+var iamPolicyServiceActions = map[string]map[string]bool{
+	"dynamodb": iamPolicyCatalogSet(
+		"BatchGetItem",
+		"BatchWriteItem",
+		"ConditionCheckItem",
+		"CreateBackup",
+		"CreateGlobalTable",
+		"CreateTable",
+		"DeleteBackup",
+		"DeleteItem",
+		"DeleteTable",
+		"DescribeBackup",
+		"DescribeContinuousBackups",
+		"DescribeGlobalTable",
+		"DescribeGlobalTableSettings",
+		"DescribeLimits",
+		"DescribeReservedCapacity",
+		"DescribeReservedCapacityOfferings",
+		"DescribeStream",
+		"DescribeTable",
+		"DescribeTimeToLive",
+		"GetItem",
+		"GetRecords",
+		"GetShardIterator",
+		"ListBackups",
+		"ListGlobalTables",
+		"ListStreams",
+		"ListTables",
+		"ListTagsOfResource",
+		"PurchaseReservedCapacityOfferings",
+		"PutItem",
+		"Query",
+		"RestoreTableFromBackup",
+		"RestoreTableToPointInTime",
+		"Scan",
+		"TagResource",
+		"UntagResource",
+		"UpdateContinuousBackups",
+		"UpdateGlobalTable",
+		"UpdateGlobalTableSettings",
+		"UpdateItem",
+		"UpdateTable",
+		"UpdateTimeToLive",
+	),
+	"kms": iamPolicyCatalogSet(
+		"CancelKeyDeletion",
+		"ConnectCustomKeyStore",
+		"CreateAlias",
+		"CreateCustomKeyStore",
+		"CreateGrant",
+		"CreateKey",
+		"Decrypt",
+		"DeleteAlias",
+		"DeleteCustomKeyStore",
+		"DeleteImportedKeyMaterial",
+		"DescribeCustomKeyStores",
+		"DescribeKey",
+		"DisableKey",
+		"DisableKeyRotation",
+		"DisconnectCustomKeyStore",
+		"EnableKey",
+		"EnableKeyRotation",
+		"Encrypt",
+		"GenerateDataKey",
+		"GenerateDataKeyWithoutPlaintext",
+		"GenerateRandom",
+		"GetKeyPolicy",
+		"GetKeyRotationStatus",
+		"GetParametersForImport",
+		"ImportKeyMaterial",
+		"ListAliases",
+		"ListGrants",
+		"ListKeyPolicies",
+		"ListKeys",
+		"ListResourceTags",
+		"ListRetirableGrants",
+		"PutKeyPolicy",
+		"ReEncryptFrom",
+		"ReEncryptTo",
+		"RetireGrant",
+		"RevokeGrant",
+		"ScheduleKeyDeletion",
+		"TagResource",
+		"UntagResource",
+		"UpdateAlias",
+		"UpdateCustomKeyStore",
+		"UpdateKeyDescription",
+	),
+	"lambda": iamPolicyCatalogSet(
+		"AddLayerVersionPermission",
+		"AddPermission",
+		"CreateAlias",
+		"CreateEventSourceMapping",
+		"CreateFunction",
+		"DeleteAlias",
+		"DeleteEventSourceMapping",
+		"DeleteFunction",
+		"DeleteFunctionConcurrency",
+		"DeleteLayerVersion",
+		"DisableReplication",
+		"EnableReplication",
+		"GetAccountSettings",
+		"GetAlias",
+		"GetEventSourceMapping",
+		"GetFunction",
+		"GetFunctionConfiguration",
+		"GetLayerVersion",
+		"GetLayerVersionPolicy",
+		"GetPolicy",
+		"InvokeAsync",
+		"InvokeFunction",
+		"ListAliases",
+		"ListEventSourceMappings",
+		"ListFunctions",
+		"ListLayerVersions",
+		"ListLayers",
+		"ListTags",
+		"ListVersionsByFunction",
+		"PublishLayerVersion",
+		"PublishVersion",
+		"PutFunctionConcurrency",
+		"RemoveLayerVersionPermission",
+		"RemovePermission",
+		"TagResource",
+		"UntagResource",
+		"UpdateAlias",
+		"UpdateEventSourceMapping",
+		"UpdateFunctionCode",
+		"UpdateFunctionConfiguration",
+	),
+	"logs": iamPolicyCatalogSet(
+		"AssociateKmsKey",
+		"CancelExportTask",
+		"CreateExportTask",
+		"CreateLogDelivery",
+		"CreateLogGroup",
+		"CreateLogStream",
+		"DeleteDestination",
+		"DeleteLogDelivery",
+		"DeleteLogGroup",
+		"DeleteLogStream",
+		"DeleteMetricFilter",
+		"DeleteResourcePolicy",
+		"DeleteRetentionPolicy",
+		"DeleteSubscriptionFilter",
+		"DescribeDestinations",
+		"DescribeExportTasks",
+		"DescribeLogGroups",
+		"DescribeLogStreams",
+		"DescribeMetricFilters",
+		"DescribeQueries",
+		"DescribeResourcePolicies",
+		"DescribeSubscriptionFilters",
+		"DisassociateKmsKey",
+		"FilterLogEvents",
+		"GetLogDelivery",
+		"GetLogEvents",
+		"GetLogGroupFields",
+		"GetLogRecord",
+		"GetQueryResults",
+		"ListLogDeliveries",
+		"ListTagsLogGroup",
+		"PutDestination",
+		"PutDestinationPolicy",
+		"PutLogEvents",
+		"PutMetricFilter",
+		"PutResourcePolicy",
+		"PutRetentionPolicy",
+		"PutSubscriptionFilter",
+		"StartQuery",
+		"StopQuery",
+		"TagLogGroup",
+		"TestMetricFilter",
+		"UntagLogGroup",
+		"UpdateLogDelivery",
+	),
+	"s3": iamPolicyCatalogSet(
+		"AbortMultipartUpload",
+		"BypassGovernanceRetention",
+		"CreateBucket",
+		"CreateJob",
+		"DeleteBucket",
+		"DeleteBucketPolicy",
+		"DeleteBucketWebsite",
+		"DeleteObject",
+		"DeleteObjectTagging",
+		"DeleteObjectVersion",
+		"DeleteObjectVersionTagging",
+		"DescribeJob",
+		"GetAccelerateConfiguration",
+		"GetAccountPublicAccessBlock",
+		"GetAnalyticsConfiguration",
+		"GetBucketAcl",
+		"GetBucketCORS",
+		"GetBucketLocation",
+		"GetBucketLogging",
+		"GetBucketNotification",
+		"GetBucketObjectLockConfiguration",
+		"GetBucketPolicy",
+		"GetBucketPolicyStatus",
+		"GetBucketPublicAccessBlock",
+		"GetBucketRequestPayment",
+		"GetBucketTagging",
+		"GetBucketVersioning",
+		"GetBucketWebsite",
+		"GetEncryptionConfiguration",
+		"GetInventoryConfiguration",
+		"GetLifecycleConfiguration",
+		"GetMetricsConfiguration",
+		"GetObject",
+		"GetObjectAcl",
+		"GetObjectLegalHold",
+		"GetObjectRetention",
+		"GetObjectTagging",
+		"GetObjectTorrent",
+		"GetObjectVersion",
+		"GetObjectVersionAcl",
+		"GetObjectVersionForReplication",
+		"GetObjectVersionTagging",
+		"GetObjectVersionTorrent",
+		"GetReplicationConfiguration",
+		"ListAllMyBuckets",
+		"ListBucket",
+		"ListBucketByTags",
+		"ListBucketMultipartUploads",
+		"ListBucketVersions",
+		"ListJobs",
+		"ListMultipartUploadParts",
+		"ObjectOwnerOverrideToBucketOwner",
+		"PutAccelerateConfiguration",
+		"PutAccountPublicAccessBlock",
+		"PutAnalyticsConfiguration",
+		"PutBucketAcl",
+		"PutBucketCORS",
+		"PutBucketLogging",
+		"PutBucketNotification",
+		"PutBucketObjectLockConfiguration",
+		"PutBucketPolicy",
+		"PutBucketPublicAccessBlock",
+		"PutBucketRequestPayment",
+		"PutBucketTagging",
+		"PutBucketVersioning",
+		"PutBucketWebsite",
+		"PutEncryptionConfiguration",
+		"PutInventoryConfiguration",
+		"PutLifecycleConfiguration",
+		"PutMetricsConfiguration",
+		"PutObject",
+		"PutObjectAcl",
+		"PutObjectLegalHold",
+		"PutObjectRetention",
+		"PutObjectTagging",
+		"PutObjectVersionAcl",
+		"PutObjectVersionTagging",
+		"PutReplicationConfiguration",
+		"ReplicateDelete",
+		"ReplicateObject",
+		"ReplicateTags",
+		"RestoreObject",
+		"UpdateJobPriority",
+		"UpdateJobStatus",
+	),
+	"sns": iamPolicyCatalogSet(
+		"AddPermission",
+		"CheckIfPhoneNumberIsOptedOut",
+		"ConfirmSubscription",
+		"CreatePlatformApplication",
+		"CreatePlatformEndpoint",
+		"CreateTopic",
+		"DeleteEndpoint",
+		"DeletePlatformApplication",
+		"DeleteTopic",
+		"GetEndpointAttributes",
+		"GetPlatformApplicationAttributes",
+		"GetSMSAttributes",
+		"GetSubscriptionAttributes",
+		"GetTopicAttributes",
+		"ListEndpointsByPlatformApplication",
+		"ListPhoneNumbersOptedOut",
+		"ListPlatformApplications",
+		"ListSubscriptions",
+		"ListSubscriptionsByTopic",
+		"ListTagsForResource",
+		"ListTopics",
+		"OptInPhoneNumber",
+		"Publish",
+		"RemovePermission",
+		"SetEndpointAttributes",
+		"SetPlatformApplicationAttributes",
+		"SetSMSAttributes",
+		"SetSubscriptionAttributes",
+		"SetTopicAttributes",
+		"Subscribe",
+		"TagResource",
+		"Unsubscribe",
+		"UntagResource",
+	),
+	"sqs": iamPolicyCatalogSet(
+		"AddPermission",
+		"ChangeMessageVisibility",
+		"ChangeMessageVisibilityBatch",
+		"CreateQueue",
+		"DeleteMessage",
+		"DeleteMessageBatch",
+		"DeleteQueue",
+		"GetQueueAttributes",
+		"GetQueueUrl",
+		"ListDeadLetterSourceQueues",
+		"ListQueueTags",
+		"ListQueues",
+		"PurgeQueue",
+		"ReceiveMessage",
+		"RemovePermission",
+		"SendMessage",
+		"SendMessageBatch",
+		"SetQueueAttributes",
+		"TagQueue",
+		"UntagQueue",
+	),
+	"sts": iamPolicyCatalogSet(
+		"AssumeRole",
+		"AssumeRoleWithSAML",
+		"AssumeRoleWithWebIdentity",
+		"DecodeAuthorizationMessage",
+		"GetAccessKeyInfo",
+		"GetCallerIdentity",
+		"GetFederationToken",
+		"GetSessionToken",
+		"TagSession",
+	),
+}
+
+// iamPolicyConditionOperators is the set of condition operators, without
+// the IfExists suffix or set operator prefixes.
+var iamPolicyConditionOperators = iamPolicyCatalogSet(
+	"ArnEquals",
+	"ArnLike",
+	"ArnNotEquals",
+	"ArnNotLike",
+	"BinaryEquals",
+	"Bool",
+	"DateEquals",
+	"DateGreaterThan",
+	"DateGreaterThanEquals",
+	"DateLessThan",
+	"DateLessThanEquals",
+	"DateNotEquals",
+	"IpAddress",
+	"NotIpAddress",
+	"Null",
+	"NumericEquals",
+	"NumericGreaterThan",
+	"NumericGreaterThanEquals",
+	"NumericLessThan",
+	"NumericLessThanEquals",
+	"NumericNotEquals",
+	"StringEquals",
+	"StringEqualsIgnoreCase",
+	"StringLike",
+	"StringNotEquals",
+	"StringNotEqualsIgnoreCase",
+	"StringNotLike",
+)
+
+// iamPolicyGlobalConditionKeys is the set of aws: condition context keys.
+// Keys ending in "/" take a suffix, e.g. aws:RequestTag/Name.
+var iamPolicyGlobalConditionKeys = iamPolicyCatalogSet(
+	"aws:CurrentTime",
+	"aws:EpochTime",
+	"aws:MultiFactorAuthAge",
+	"aws:MultiFactorAuthPresent",
+	"aws:PrincipalOrgID",
+	"aws:PrincipalTag/",
+	"aws:PrincipalType",
+	"aws:Referer",
+	"aws:RequestedRegion",
+	"aws:RequestTag/",
+	"aws:ResourceTag/",
+	"aws:SecureTransport",
+	"aws:SourceAccount",
+	"aws:SourceArn",
+	"aws:SourceIp",
+	"aws:SourceVpc",
+	"aws:SourceVpce",
+	"aws:TagKeys",
+	"aws:TokenIssueTime",
+	"aws:UserAgent",
+	"aws:userid",
+	"aws:username",
+	"aws:VpcSourceIp",
+)
+
+// iamPolicyCatalogSet builds a lookup set keyed by the lower-cased values,
+// as IAM matches actions, operators and condition keys case-insensitively.
+func iamPolicyCatalogSet(values ...string) map[string]bool {
+	m := make(map[string]bool, len(values))
+	for _, v := range values {
+		m[strings.ToLower(v)] = true
+	}
+	return m
+}
@@ -128,7 +128,11 @@ func (ps *IAMPolicyStatementPrincipalSet) UnmarshalJSON(b []byte) error {
 			case []interface{}:
 				values := []string{}
 				for _, v := range value.([]interface{}) {
-					values = append(values, v.(string))
+					sv, ok := v.(string)
+					if !ok {
+						return fmt.Errorf("Unsupported data type %T for IAMPolicyStatementPrincipalSet.Identifiers element", v)
+					}
+					values = append(values, sv)
 				}
 				out = append(out, IAMPolicyStatementPrincipal{Type: key, Identifiers: values})
 			default:
@@ -183,9 +187,12 @@ func (cs *IAMPolicyStatementConditionSet) UnmarshalJSON(b []byte) error {
 			case []interface{}:
 				values := []string{}
 				for _, v := range var_values.([]interface{}) {
-					values = append(values, v.(string))
+					values = append(values, fmt.Sprint(v))
 				}
 				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: values})
+			case bool, float64:
+				// Policies may use JSON booleans and numbers, e.g. "aws:SecureTransport": false.
+				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: []string{fmt.Sprint(var_values)}})
 			}
 		}
 	}
@@ -205,3 +212,31 @@ func iamPolicyDecodeConfigStringList(lI []interface{}) interface{} {
 	sort.Sort(sort.Reverse(sort.StringSlice(ret)))
 	return ret
 }
+
+// normalizeIAMPolicyDocument renders a JSON policy document through
+// IAMPolicyDoc so that equivalent documents produce the same JSON.
+func normalizeIAMPolicyDocument(document string) (string, error) {
+	// IAMPolicyDoc expects a list of statements, while IAM also accepts a
+	// single statement object.
+	var raw map[string]interface{}
+	if err := json.Unmarshal([]byte(document), &raw); err != nil {
+		return "", err
+	}
+	if s, ok := raw["Statement"].(map[string]interface{}); ok {
+		raw["Statement"] = []interface{}{s}
+	}
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return "", err
+	}
+
+	doc := &IAMPolicyDoc{}
+	if err := json.Unmarshal(b, doc); err != nil {
+		return "", err
+	}
+	out, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
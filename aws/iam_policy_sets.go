@@ -0,0 +1,459 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+)
+
+// The policy kinds an IAM role, user or group can manage authoritatively.
+const (
+	iamPolicyKindInline  = "inline"
+	iamPolicyKindManaged = "managed"
+)
+
+// iamPolicyEntity holds the IAM API calls used to manage the inline and
+// managed policies of one kind of principal (role, user or group).
+type iamPolicyEntity struct {
+	kind string
+
+	listInline   func(conn *iam.IAM, name string) ([]string, error)
+	getInline    func(conn *iam.IAM, name, policyName string) (string, error)
+	putInline    func(conn *iam.IAM, name, policyName, document string) error
+	deleteInline func(conn *iam.IAM, name, policyName string) error
+
+	listManaged func(conn *iam.IAM, name string) ([]string, error)
+	attach      func(conn *iam.IAM, name, arn string) error
+	detach      func(conn *iam.IAM, name, arn string) error
+}
+
+var iamRolePolicyEntity = &iamPolicyEntity{
+	kind: "role",
+	listInline: func(conn *iam.IAM, name string) ([]string, error) {
+		var names []string
+		err := conn.ListRolePoliciesPages(&iam.ListRolePoliciesInput{
+			RoleName: aws.String(name),
+		}, func(page *iam.ListRolePoliciesOutput, lastPage bool) bool {
+			names = append(names, aws.StringValueSlice(page.PolicyNames)...)
+			return !lastPage
+		})
+		return names, err
+	},
+	getInline: func(conn *iam.IAM, name, policyName string) (string, error) {
+		resp, err := conn.GetRolePolicy(&iam.GetRolePolicyInput{
+			RoleName:   aws.String(name),
+			PolicyName: aws.String(policyName),
+		})
+		if err != nil {
+			return "", err
+		}
+		return url.QueryUnescape(aws.StringValue(resp.PolicyDocument))
+	},
+	putInline: func(conn *iam.IAM, name, policyName, document string) error {
+		_, err := conn.PutRolePolicy(&iam.PutRolePolicyInput{
+			RoleName:       aws.String(name),
+			PolicyName:     aws.String(policyName),
+			PolicyDocument: aws.String(document),
+		})
+		return err
+	},
+	deleteInline: func(conn *iam.IAM, name, policyName string) error {
+		_, err := conn.DeleteRolePolicy(&iam.DeleteRolePolicyInput{
+			RoleName:   aws.String(name),
+			PolicyName: aws.String(policyName),
+		})
+		return err
+	},
+	listManaged: func(conn *iam.IAM, name string) ([]string, error) {
+		var arns []string
+		err := conn.ListAttachedRolePoliciesPages(&iam.ListAttachedRolePoliciesInput{
+			RoleName: aws.String(name),
+		}, func(page *iam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
+			for _, p := range page.AttachedPolicies {
+				arns = append(arns, aws.StringValue(p.PolicyArn))
+			}
+			return !lastPage
+		})
+		return arns, err
+	},
+	attach: attachPolicyToRole,
+	detach: detachPolicyFromRole,
+}
+
+var iamUserPolicyEntity = &iamPolicyEntity{
+	kind: "user",
+	listInline: func(conn *iam.IAM, name string) ([]string, error) {
+		var names []string
+		err := conn.ListUserPoliciesPages(&iam.ListUserPoliciesInput{
+			UserName: aws.String(name),
+		}, func(page *iam.ListUserPoliciesOutput, lastPage bool) bool {
+			names = append(names, aws.StringValueSlice(page.PolicyNames)...)
+			return !lastPage
+		})
+		return names, err
+	},
+	getInline: func(conn *iam.IAM, name, policyName string) (string, error) {
+		resp, err := conn.GetUserPolicy(&iam.GetUserPolicyInput{
+			UserName:   aws.String(name),
+			PolicyName: aws.String(policyName),
+		})
+		if err != nil {
+			return "", err
+		}
+		return url.QueryUnescape(aws.StringValue(resp.PolicyDocument))
+	},
+	putInline: func(conn *iam.IAM, name, policyName, document string) error {
+		_, err := conn.PutUserPolicy(&iam.PutUserPolicyInput{
+			UserName:       aws.String(name),
+			PolicyName:     aws.String(policyName),
+			PolicyDocument: aws.String(document),
+		})
+		return err
+	},
+	deleteInline: func(conn *iam.IAM, name, policyName string) error {
+		_, err := conn.DeleteUserPolicy(&iam.DeleteUserPolicyInput{
+			UserName:   aws.String(name),
+			PolicyName: aws.String(policyName),
+		})
+		return err
+	},
+	listManaged: func(conn *iam.IAM, name string) ([]string, error) {
+		var arns []string
+		err := conn.ListAttachedUserPoliciesPages(&iam.ListAttachedUserPoliciesInput{
+			UserName: aws.String(name),
+		}, func(page *iam.ListAttachedUserPoliciesOutput, lastPage bool) bool {
+			for _, p := range page.AttachedPolicies {
+				arns = append(arns, aws.StringValue(p.PolicyArn))
+			}
+			return !lastPage
+		})
+		return arns, err
+	},
+	attach: attachPolicyToUser,
+	detach: detachPolicyFromUser,
+}
+
+var iamGroupPolicyEntity = &iamPolicyEntity{
+	kind: "group",
+	listInline: func(conn *iam.IAM, name string) ([]string, error) {
+		var names []string
+		err := conn.ListGroupPoliciesPages(&iam.ListGroupPoliciesInput{
+			GroupName: aws.String(name),
+		}, func(page *iam.ListGroupPoliciesOutput, lastPage bool) bool {
+			names = append(names, aws.StringValueSlice(page.PolicyNames)...)
+			return !lastPage
+		})
+		return names, err
+	},
+	getInline: func(conn *iam.IAM, name, policyName string) (string, error) {
+		resp, err := conn.GetGroupPolicy(&iam.GetGroupPolicyInput{
+			GroupName:  aws.String(name),
+			PolicyName: aws.String(policyName),
+		})
+		if err != nil {
+			return "", err
+		}
+		return url.QueryUnescape(aws.StringValue(resp.PolicyDocument))
+	},
+	putInline: func(conn *iam.IAM, name, policyName, document string) error {
+		_, err := conn.PutGroupPolicy(&iam.PutGroupPolicyInput{
+			GroupName:      aws.String(name),
+			PolicyName:     aws.String(policyName),
+			PolicyDocument: aws.String(document),
+		})
+		return err
+	},
+	deleteInline: func(conn *iam.IAM, name, policyName string) error {
+		_, err := conn.DeleteGroupPolicy(&iam.DeleteGroupPolicyInput{
+			GroupName:  aws.String(name),
+			PolicyName: aws.String(policyName),
+		})
+		return err
+	},
+	listManaged: func(conn *iam.IAM, name string) ([]string, error) {
+		var arns []string
+		err := conn.ListAttachedGroupPoliciesPages(&iam.ListAttachedGroupPoliciesInput{
+			GroupName: aws.String(name),
+		}, func(page *iam.ListAttachedGroupPoliciesOutput, lastPage bool) bool {
+			for _, p := range page.AttachedPolicies {
+				arns = append(arns, aws.StringValue(p.PolicyArn))
+			}
+			return !lastPage
+		})
+		return arns, err
+	},
+	attach: attachPolicyToGroup,
+	detach: detachPolicyFromGroup,
+}
+
+func iamInlinePolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"policy": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateFunc:     validateIAMIdentityPolicyJson,
+					DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				},
+			},
+		},
+		Set: iamInlinePolicyHash,
+	}
+}
+
+func iamManagedPolicyArnsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validateArn,
+		},
+		Set: schema.HashString,
+	}
+}
+
+func iamInlinePolicyHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["name"].(string)))
+	// Hash the normalized document so formatting differences from the API
+	// don't show up as a diff.
+	policy, err := structure.NormalizeJsonString(m["policy"].(string))
+	if err != nil {
+		policy = m["policy"].(string)
+	}
+	buf.WriteString(fmt.Sprintf("%s-", policy))
+	return hashcode.String(buf.String())
+}
+
+// readIamPolicySets refreshes inline_policy and managed_policy_arns. Each is
+// only read once set, so principals that don't use them are unaffected.
+func readIamPolicySets(d *schema.ResourceData, conn *iam.IAM, e *iamPolicyEntity, name string) error {
+	if v, ok := d.GetOk("inline_policy"); ok && v.(*schema.Set).Len() > 0 {
+		names, err := e.listInline(conn, name)
+		if err != nil {
+			return fmt.Errorf("Error listing inline policies for IAM %s (%s): %s", e.kind, name, err)
+		}
+
+		policies := make([]interface{}, 0, len(names))
+		for _, policyName := range names {
+			document, err := e.getInline(conn, name, policyName)
+			if err != nil {
+				return fmt.Errorf("Error reading inline policy %s of IAM %s (%s): %s", policyName, e.kind, name, err)
+			}
+			policies = append(policies, map[string]interface{}{
+				"name":   policyName,
+				"policy": document,
+			})
+		}
+
+		if err := d.Set("inline_policy", schema.NewSet(iamInlinePolicyHash, policies)); err != nil {
+			return fmt.Errorf("error setting inline_policy: %s", err)
+		}
+	}
+
+	if v, ok := d.GetOk("managed_policy_arns"); ok && v.(*schema.Set).Len() > 0 {
+		arns, err := e.listManaged(conn, name)
+		if err != nil {
+			return fmt.Errorf("Error listing attached policies for IAM %s (%s): %s", e.kind, name, err)
+		}
+
+		if err := d.Set("managed_policy_arns", arns); err != nil {
+			return fmt.Errorf("error setting managed_policy_arns: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// updateIamPolicySets makes the inline and attached policies of a principal
+// match inline_policy and managed_policy_arns. The principal's current
+// policies are listed so that policies added outside of Terraform since the
+// last refresh are removed as well.
+func updateIamPolicySets(d *schema.ResourceData, conn *iam.IAM, e *iamPolicyEntity, name string) error {
+	if d.HasChange("inline_policy") {
+		o, n := d.GetChange("inline_policy")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		remove := make(map[string]bool)
+		for _, v := range os.List() {
+			remove[v.(map[string]interface{})["name"].(string)] = true
+		}
+		if ns.Len() > 0 {
+			names, err := e.listInline(conn, name)
+			if err != nil {
+				return fmt.Errorf("Error listing inline policies for IAM %s (%s): %s", e.kind, name, err)
+			}
+			for _, policyName := range names {
+				remove[policyName] = true
+			}
+		}
+
+		for _, v := range ns.List() {
+			m := v.(map[string]interface{})
+			policyName := m["name"].(string)
+			delete(remove, policyName)
+
+			log.Printf("[DEBUG] Putting inline policy %s on IAM %s (%s)", policyName, e.kind, name)
+			if err := e.putInline(conn, name, policyName, m["policy"].(string)); err != nil {
+				return fmt.Errorf("Error putting inline policy %s on IAM %s (%s): %s", policyName, e.kind, name, err)
+			}
+		}
+
+		for policyName := range remove {
+			log.Printf("[DEBUG] Deleting inline policy %s from IAM %s (%s)", policyName, e.kind, name)
+			if err := e.deleteInline(conn, name, policyName); err != nil && !isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+				return fmt.Errorf("Error deleting inline policy %s from IAM %s (%s): %s", policyName, e.kind, name, err)
+			}
+		}
+	}
+
+	if d.HasChange("managed_policy_arns") {
+		o, n := d.GetChange("managed_policy_arns")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		current := os
+		if ns.Len() > 0 {
+			arns, err := e.listManaged(conn, name)
+			if err != nil {
+				return fmt.Errorf("Error listing attached policies for IAM %s (%s): %s", e.kind, name, err)
+			}
+			current = schema.NewSet(schema.HashString, nil)
+			for _, arn := range arns {
+				current.Add(arn)
+			}
+		}
+
+		for _, v := range current.Difference(ns).List() {
+			arn := v.(string)
+			log.Printf("[DEBUG] Detaching policy %s from IAM %s (%s)", arn, e.kind, name)
+			if err := e.detach(conn, name, arn); err != nil && !isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+				return fmt.Errorf("Error detaching policy %s from IAM %s (%s): %s", arn, e.kind, name, err)
+			}
+		}
+
+		for _, v := range ns.Difference(current).List() {
+			arn := v.(string)
+			log.Printf("[DEBUG] Attaching policy %s to IAM %s (%s)", arn, e.kind, name)
+			if err := e.attach(conn, name, arn); err != nil {
+				return fmt.Errorf("Error attaching policy %s to IAM %s (%s): %s", arn, e.kind, name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// deleteIamPolicySets removes the policies managed through inline_policy and
+// managed_policy_arns so that the principal itself can be deleted.
+func deleteIamPolicySets(d *schema.ResourceData, conn *iam.IAM, e *iamPolicyEntity, name string) error {
+	for _, v := range d.Get("inline_policy").(*schema.Set).List() {
+		policyName := v.(map[string]interface{})["name"].(string)
+		if err := e.deleteInline(conn, name, policyName); err != nil && !isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+			return fmt.Errorf("Error deleting inline policy %s from IAM %s (%s): %s", policyName, e.kind, name, err)
+		}
+	}
+
+	for _, v := range d.Get("managed_policy_arns").(*schema.Set).List() {
+		arn := v.(string)
+		if err := e.detach(conn, name, arn); err != nil && !isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+			return fmt.Errorf("Error detaching policy %s from IAM %s (%s): %s", arn, e.kind, name, err)
+		}
+	}
+
+	return nil
+}
+
+// customizeDiffIamPolicySets returns a CustomizeDiffFunc that records the
+// principal's authoritative policy sets so that standalone policy resources
+// targeting the same principal are reported as a conflict at plan time.
+func customizeDiffIamPolicySets(e *iamPolicyEntity) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.NewValueKnown("name") {
+			return nil
+		}
+		name := diff.Get("name").(string)
+		if name == "" {
+			return nil
+		}
+
+		ownership := meta.(*AWSClient).resourceOwnership
+		if v, ok := diff.GetOk("inline_policy"); ok && v.(*schema.Set).Len() > 0 {
+			if err := claimIamPolicyAuthoritative(ownership, e.kind, name, iamPolicyKindInline, "inline_policy"); err != nil {
+				return err
+			}
+		}
+		if v, ok := diff.GetOk("managed_policy_arns"); ok && v.(*schema.Set).Len() > 0 {
+			if err := claimIamPolicyAuthoritative(ownership, e.kind, name, iamPolicyKindManaged, "managed_policy_arns"); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// customizeDiffIamStandalonePolicy returns a CustomizeDiffFunc for the
+// standalone policy resources that fails when the targeted principal, read
+// from key, manages the same kind of policies authoritatively.
+func customizeDiffIamStandalonePolicy(resourceType, entityKind, policyKind, key string) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+
+		ownership := meta.(*AWSClient).resourceOwnership
+		switch v := diff.Get(key).(type) {
+		case string:
+			if v != "" {
+				return claimIamPolicyStandalone(ownership, entityKind, v, policyKind, resourceType)
+			}
+		case *schema.Set:
+			for _, name := range v.List() {
+				if err := claimIamPolicyStandalone(ownership, entityKind, name.(string), policyKind, resourceType); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func iamPolicyOwnershipKey(entityKind, name, policyKind string) string {
+	return fmt.Sprintf("iam/%s/%s/%s", entityKind, name, policyKind)
+}
+
+func claimIamPolicyAuthoritative(o *resourceOwnership, entityKind, name, policyKind, attribute string) error {
+	if resourceType, ok := o.claimAuthoritative(iamPolicyOwnershipKey(entityKind, name, policyKind), attribute); ok {
+		return iamPolicyOwnershipConflict(entityKind, name, attribute, resourceType)
+	}
+	return nil
+}
+
+func claimIamPolicyStandalone(o *resourceOwnership, entityKind, name, policyKind, resourceType string) error {
+	if attribute, ok := o.claimStandalone(iamPolicyOwnershipKey(entityKind, name, policyKind), resourceType); ok {
+		return iamPolicyOwnershipConflict(entityKind, name, attribute, resourceType)
+	}
+	return nil
+}
+
+func iamPolicyOwnershipConflict(entityKind, name, attribute, resourceType string) error {
+	return fmt.Errorf("IAM %s %q: %s conflicts with %s, policies not listed in %s are removed from the %s",
+		entityKind, name, attribute, resourceType, attribute, entityKind)
+}
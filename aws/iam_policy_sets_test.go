@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestIamPolicyOwnership(t *testing.T) {
+	o := newResourceOwnership()
+
+	if err := claimIamPolicyAuthoritative(o, "role", "test", iamPolicyKindManaged, "managed_policy_arns"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// Other kinds of policies and other principals are unaffected.
+	if err := claimIamPolicyStandalone(o, "role", "test", iamPolicyKindInline, "aws_iam_role_policy"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := claimIamPolicyStandalone(o, "user", "test", iamPolicyKindManaged, "aws_iam_user_policy_attachment"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err := claimIamPolicyStandalone(o, "role", "test", iamPolicyKindManaged, "aws_iam_role_policy_attachment")
+	if err == nil {
+		t.Fatal("expected conflict between managed_policy_arns and aws_iam_role_policy_attachment")
+	}
+	if !regexp.MustCompile(`managed_policy_arns conflicts with aws_iam_role_policy_attachment`).MatchString(err.Error()) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The conflict is reported whichever side is planned last.
+	err = claimIamPolicyAuthoritative(o, "role", "test", iamPolicyKindInline, "inline_policy")
+	if err == nil {
+		t.Fatal("expected conflict between inline_policy and aws_iam_role_policy")
+	}
+	if !regexp.MustCompile(`inline_policy conflicts with aws_iam_role_policy`).MatchString(err.Error()) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestIamInlinePolicyHash(t *testing.T) {
+	a := map[string]interface{}{
+		"name":   "test",
+		"policy": `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`,
+	}
+	b := map[string]interface{}{
+		"name": "test",
+		"policy": `{
+  "Version": "2012-10-17",
+  "Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": "*"}]
+}`,
+	}
+	c := map[string]interface{}{
+		"name":   "other",
+		"policy": a["policy"],
+	}
+
+	if iamInlinePolicyHash(a) != iamInlinePolicyHash(b) {
+		t.Fatal("expected equivalent policies to have the same hash")
+	}
+	if iamInlinePolicyHash(a) == iamInlinePolicyHash(c) {
+		t.Fatal("expected policies with different names to have different hashes")
+	}
+}
@@ -0,0 +1,464 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// iamPolicyDocumentType selects the element rules applied by
+// validateIAMPolicyDocument on top of the checks common to all policies.
+type iamPolicyDocumentType int
+
+const (
+	// iamPolicyDocumentAny is a policy of unknown use, e.g. a resource policy
+	// or the output of aws_iam_policy_document.
+	iamPolicyDocumentAny iamPolicyDocumentType = iota
+	// iamPolicyDocumentIdentity is a policy attached to a user, group or role,
+	// which must not name a principal and must name resources.
+	iamPolicyDocumentIdentity
+	// iamPolicyDocumentTrust is a role's assume role policy, which must name a
+	// principal and must not name resources.
+	iamPolicyDocumentTrust
+)
+
+var iamPolicyPartitions = map[string]bool{
+	"aws":        true,
+	"aws-cn":     true,
+	"aws-us-gov": true,
+}
+
+var iamPolicyPrincipalTypes = map[string]bool{
+	"*":             true,
+	"AWS":           true,
+	"CanonicalUser": true,
+	"Federated":     true,
+	"Service":       true,
+}
+
+var iamPolicyDocumentKeys = map[string]bool{
+	"Id":        true,
+	"Statement": true,
+	"Version":   true,
+}
+
+var iamPolicyStatementKeys = map[string]bool{
+	"Action":       true,
+	"Condition":    true,
+	"Effect":       true,
+	"NotAction":    true,
+	"NotPrincipal": true,
+	"NotResource":  true,
+	"Principal":    true,
+	"Resource":     true,
+	"Sid":          true,
+}
+
+var (
+	iamPolicyActionRegexp    = regexp.MustCompile(`^[a-zA-Z0-9-]+:[a-zA-Z0-9*?]+$`)
+	iamPolicyAccountIdRegexp = regexp.MustCompile(`^\d{12}$`)
+	iamPolicySidRegexp       = regexp.MustCompile(`^[a-zA-Z0-9]*$`)
+)
+
+// iamPolicyValidator collects the findings of a single document validation.
+type iamPolicyValidator struct {
+	docType    iamPolicyDocumentType
+	partition  string
+	partitions map[string]string
+
+	warnings []string
+	errors   []error
+}
+
+// validateIAMPolicyDocument statically checks an IAM policy document against
+// the policy grammar and the catalog in iam_policy_catalog.go. Structural
+// problems that IAM would reject are returned as errors, prefixed with the
+// statement and element they were found in. Unknown services, actions and
+// global condition keys are returned as warnings since the catalog can lag
+// behind newly released services. A non-empty partition requires every ARN
+// in the document to belong to it.
+func validateIAMPolicyDocument(document string, docType iamPolicyDocumentType, partition string) ([]string, []error) {
+	v := &iamPolicyValidator{
+		docType:    docType,
+		partition:  partition,
+		partitions: make(map[string]string),
+	}
+	v.validateDocument(document)
+	return v.warnings, v.errors
+}
+
+func (v *iamPolicyValidator) errorf(path, format string, args ...interface{}) {
+	v.errors = append(v.errors, fmt.Errorf("%s: %s", path, fmt.Sprintf(format, args...)))
+}
+
+func (v *iamPolicyValidator) warnf(path, format string, args ...interface{}) {
+	v.warnings = append(v.warnings, fmt.Sprintf("%s: %s", path, fmt.Sprintf(format, args...)))
+}
+
+func (v *iamPolicyValidator) validateDocument(document string) {
+	var raw map[string]interface{}
+	if err := json.Unmarshal([]byte(document), &raw); err != nil {
+		v.errorf("policy", "invalid JSON: %s", err)
+		return
+	}
+
+	for _, k := range iamPolicySortedKeys(raw) {
+		if !iamPolicyDocumentKeys[k] {
+			v.errorf(k, "unsupported policy element")
+		}
+	}
+
+	if version, ok := raw["Version"]; ok {
+		if s, _ := version.(string); s != "2012-10-17" && s != "2008-10-17" {
+			v.errorf("Version", "must be \"2012-10-17\" or \"2008-10-17\", got %v", version)
+		}
+	}
+	if id, ok := raw["Id"]; ok {
+		if _, ok := id.(string); !ok {
+			v.errorf("Id", "must be a string")
+		}
+	}
+
+	var statements []interface{}
+	switch s := raw["Statement"].(type) {
+	case nil:
+		if v.docType != iamPolicyDocumentAny {
+			v.errorf("Statement", "required")
+		}
+		return
+	case map[string]interface{}:
+		statements = []interface{}{s}
+	case []interface{}:
+		statements = s
+	default:
+		v.errorf("Statement", "must be an object or a list of objects")
+		return
+	}
+
+	if len(statements) == 0 && v.docType != iamPolicyDocumentAny {
+		v.errorf("Statement", "must contain at least one statement")
+	}
+	for i, s := range statements {
+		v.validateStatement(fmt.Sprintf("Statement[%d]", i), s)
+	}
+}
+
+func (v *iamPolicyValidator) validateStatement(prefix string, s interface{}) {
+	raw, ok := s.(map[string]interface{})
+	if !ok {
+		v.errorf(prefix, "must be an object")
+		return
+	}
+
+	if sid, ok := raw["Sid"].(string); ok && sid != "" {
+		prefix = fmt.Sprintf("%s (Sid %q)", prefix, sid)
+	}
+	at := func(element string) string { return prefix + "." + element }
+
+	for _, k := range iamPolicySortedKeys(raw) {
+		if !iamPolicyStatementKeys[k] {
+			v.errorf(at(k), "unsupported statement element")
+		}
+	}
+
+	b, _ := json.Marshal(raw)
+	stmt := &IAMPolicyStatement{}
+	if err := json.Unmarshal(b, stmt); err != nil {
+		v.errorf(prefix, "%s", err)
+		return
+	}
+
+	if v.docType != iamPolicyDocumentAny && !iamPolicySidRegexp.MatchString(stmt.Sid) {
+		v.errorf(at("Sid"), "must only contain alphanumeric characters, got %q", stmt.Sid)
+	}
+
+	if stmt.Effect != "Allow" && stmt.Effect != "Deny" {
+		v.errorf(at("Effect"), "must be \"Allow\" or \"Deny\", got %q", stmt.Effect)
+	}
+
+	v.validateExclusive(prefix, raw, "Action", "NotAction", true)
+	v.validateActions(at("Action"), raw["Action"])
+	v.validateActions(at("NotAction"), raw["NotAction"])
+
+	switch v.docType {
+	case iamPolicyDocumentIdentity:
+		v.validateExclusive(prefix, raw, "Resource", "NotResource", true)
+		v.validateForbidden(prefix, raw, "Principal", "NotPrincipal")
+	case iamPolicyDocumentTrust:
+		v.validateExclusive(prefix, raw, "Principal", "NotPrincipal", true)
+		v.validateForbidden(prefix, raw, "Resource", "NotResource")
+	default:
+		v.validateExclusive(prefix, raw, "Resource", "NotResource", false)
+		v.validateExclusive(prefix, raw, "Principal", "NotPrincipal", false)
+	}
+	v.validateResources(at("Resource"), raw["Resource"])
+	v.validateResources(at("NotResource"), raw["NotResource"])
+
+	if p, ok := raw["Principal"].(string); ok && p != "*" {
+		v.errorf(at("Principal"), "must be \"*\" or an object, got %q", p)
+	} else {
+		v.validatePrincipals(at("Principal"), stmt.Principals)
+	}
+	if p, ok := raw["NotPrincipal"].(string); ok && p != "*" {
+		v.errorf(at("NotPrincipal"), "must be \"*\" or an object, got %q", p)
+	} else {
+		v.validatePrincipals(at("NotPrincipal"), stmt.NotPrincipals)
+	}
+
+	v.validateConditions(at("Condition"), stmt.Conditions)
+}
+
+// validateExclusive checks that at most one, or with required exactly one,
+// of the two complementary elements is present.
+func (v *iamPolicyValidator) validateExclusive(prefix string, raw map[string]interface{}, element, notElement string, required bool) {
+	_, has := raw[element]
+	_, hasNot := raw[notElement]
+	if has && hasNot {
+		v.errorf(prefix, "only one of %s or %s may be specified", element, notElement)
+	} else if required && !has && !hasNot {
+		v.errorf(prefix, "one of %s or %s is required", element, notElement)
+	}
+}
+
+func (v *iamPolicyValidator) validateForbidden(prefix string, raw map[string]interface{}, elements ...string) {
+	for _, e := range elements {
+		if _, ok := raw[e]; !ok {
+			continue
+		}
+		switch v.docType {
+		case iamPolicyDocumentIdentity:
+			v.errorf(prefix+"."+e, "not supported in identity-based policies")
+		case iamPolicyDocumentTrust:
+			v.errorf(prefix+"."+e, "not supported in assume role policies")
+		}
+	}
+}
+
+// eachString calls fn with the path of each value of an element that may be
+// a single string or a list of strings, reporting anything else.
+func (v *iamPolicyValidator) eachString(path string, raw interface{}, fn func(path, value string)) {
+	switch t := raw.(type) {
+	case nil:
+	case string:
+		fn(path+"[0]", t)
+	case []interface{}:
+		if len(t) == 0 {
+			v.errorf(path, "must not be empty")
+		}
+		for i, e := range t {
+			p := fmt.Sprintf("%s[%d]", path, i)
+			if s, ok := e.(string); ok {
+				fn(p, s)
+			} else {
+				v.errorf(p, "must be a string")
+			}
+		}
+	default:
+		v.errorf(path, "must be a string or a list of strings")
+	}
+}
+
+func (v *iamPolicyValidator) validateActions(path string, raw interface{}) {
+	v.eachString(path, raw, func(p, action string) {
+		if action == "*" {
+			return
+		}
+		if !iamPolicyActionRegexp.MatchString(action) {
+			v.errorf(p, "must be \"*\" or of the form \"service:action\", got %q", action)
+			return
+		}
+
+		parts := strings.SplitN(strings.ToLower(action), ":", 2)
+		service, name := parts[0], parts[1]
+		if !iamPolicyServicePrefixes[service] {
+			v.warnf(p, "unknown service prefix %q", parts[0])
+			return
+		}
+		actions, ok := iamPolicyServiceActions[service]
+		if !ok || name == "*" {
+			return
+		}
+		if strings.ContainsAny(name, "*?") {
+			if !iamPolicyGlobMatchesAny(name, actions) {
+				v.warnf(p, "%q matches no known %s action", action, service)
+			}
+		} else if !actions[name] {
+			v.warnf(p, "unknown %s action %q", service, action)
+		}
+	})
+}
+
+func (v *iamPolicyValidator) validateResources(path string, raw interface{}) {
+	v.eachString(path, raw, func(p, resource string) {
+		if resource == "*" {
+			return
+		}
+		if !strings.HasPrefix(resource, "arn:") {
+			v.errorf(p, "must be \"*\" or an ARN, got %q", resource)
+			return
+		}
+		v.validateArn(p, resource, true)
+	})
+}
+
+func (v *iamPolicyValidator) validatePrincipals(path string, principals IAMPolicyStatementPrincipalSet) {
+	sort.Slice(principals, func(i, j int) bool { return principals[i].Type < principals[j].Type })
+
+	for _, principal := range principals {
+		p := fmt.Sprintf("%s.%s", path, principal.Type)
+		if !iamPolicyPrincipalTypes[principal.Type] {
+			v.errorf(p, "unsupported principal type %q", principal.Type)
+			continue
+		}
+
+		var identifiers []string
+		switch t := principal.Identifiers.(type) {
+		case string:
+			identifiers = []string{t}
+		case []string:
+			identifiers = t
+		}
+		for i, identifier := range identifiers {
+			ip := fmt.Sprintf("%s[%d]", p, i)
+			switch {
+			case principal.Type == "*" && identifier != "*":
+				v.errorf(ip, "must be \"*\", got %q", identifier)
+			case principal.Type == "AWS":
+				if identifier == "*" || iamPolicyAccountIdRegexp.MatchString(identifier) {
+					continue
+				}
+				if !strings.HasPrefix(identifier, "arn:") {
+					// Policies may reference deleted principals by unique ID.
+					v.warnf(ip, "expected \"*\", an account ID or an ARN, got %q", identifier)
+					continue
+				}
+				v.validateArn(ip, identifier, false)
+			case principal.Type == "Federated" && strings.HasPrefix(identifier, "arn:"):
+				v.validateArn(ip, identifier, false)
+			}
+		}
+	}
+}
+
+// validateArn checks the partition of an ARN and that it is used
+// consistently across the document. With strict, malformed ARNs are errors.
+func (v *iamPolicyValidator) validateArn(path, arn string, strict bool) {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) < 6 {
+		if strict {
+			v.errorf(path, "invalid ARN %q", arn)
+		} else {
+			v.warnf(path, "invalid ARN %q", arn)
+		}
+		return
+	}
+
+	partition := parts[1]
+	if strings.ContainsAny(partition, "*?") || strings.Contains(partition, "${") {
+		return
+	}
+	if !iamPolicyPartitions[partition] {
+		v.errorf(path, "unknown partition %q in ARN %q", partition, arn)
+		return
+	}
+	if v.partition != "" && partition != v.partition {
+		v.errorf(path, "ARN %q is not in the %q partition", arn, v.partition)
+		return
+	}
+	for other, otherPath := range v.partitions {
+		if other != partition {
+			v.errorf(path, "ARN %q is in the %q partition but %s is in the %q partition", arn, partition, otherPath, other)
+			return
+		}
+	}
+	if _, ok := v.partitions[partition]; !ok {
+		v.partitions[partition] = path
+	}
+}
+
+func (v *iamPolicyValidator) validateConditions(path string, conditions IAMPolicyStatementConditionSet) {
+	sort.Slice(conditions, func(i, j int) bool {
+		if conditions[i].Test != conditions[j].Test {
+			return conditions[i].Test < conditions[j].Test
+		}
+		return conditions[i].Variable < conditions[j].Variable
+	})
+
+	for _, c := range conditions {
+		p := fmt.Sprintf("%s.%s", path, c.Test)
+		if !iamPolicyConditionOperatorValid(c.Test) {
+			v.errorf(p, "unknown condition operator %q", c.Test)
+		}
+		iamPolicyValidateConditionKey(p+"."+c.Variable, c.Variable, v.warnf, v.errorf)
+	}
+}
+
+// iamPolicyConditionOperatorValid reports whether op is a condition operator,
+// optionally qualified by a set operator prefix or the IfExists suffix.
+func iamPolicyConditionOperatorValid(op string) bool {
+	op = strings.ToLower(op)
+	if i := strings.Index(op, ":"); i >= 0 {
+		if prefix := op[:i]; prefix != "foranyvalue" && prefix != "forallvalues" {
+			return false
+		}
+		op = op[i+1:]
+	}
+	if strings.HasSuffix(op, "ifexists") {
+		op = strings.TrimSuffix(op, "ifexists")
+		if op == "null" {
+			return false
+		}
+	}
+	return iamPolicyConditionOperators[op]
+}
+
+// iamPolicyValidateConditionKey reports condition keys without a prefix as
+// errors and aws: keys or service prefixes missing from the catalog as
+// warnings. Keys of web identity and SAML providers are not checked.
+func iamPolicyValidateConditionKey(path, key string, warnf, errorf func(string, string, ...interface{})) {
+	i := strings.Index(key, ":")
+	if i <= 0 || i == len(key)-1 {
+		errorf(path, "condition key must be of the form \"prefix:key\", got %q", key)
+		return
+	}
+
+	prefix := strings.ToLower(key[:i])
+	switch {
+	case prefix == "aws":
+		lower := strings.ToLower(key)
+		if iamPolicyGlobalConditionKeys[lower] {
+			return
+		}
+		for k := range iamPolicyGlobalConditionKeys {
+			if strings.HasSuffix(k, "/") && strings.HasPrefix(lower, k) && len(lower) > len(k) {
+				return
+			}
+		}
+		warnf(path, "unknown global condition key %q", key)
+	case prefix == "saml" || strings.Contains(prefix, "."):
+		return
+	case !iamPolicyServicePrefixes[prefix]:
+		warnf(path, "unknown service prefix %q in condition key %q", key[:i], key)
+	}
+}
+
+func iamPolicyGlobMatchesAny(pattern string, names map[string]bool) bool {
+	for name := range names {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func iamPolicySortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,211 @@
+package aws
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateIAMPolicyDocument(t *testing.T) {
+	cases := []struct {
+		Name      string
+		Document  string
+		Type      iamPolicyDocumentType
+		Partition string
+		Errors    []string
+		Warnings  []string
+	}{
+		{
+			Name: "valid identity policy",
+			Document: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "List",
+      "Effect": "Allow",
+      "Action": ["s3:ListBucket", "s3:Get*"],
+      "Resource": "arn:aws:s3:::bucket",
+      "Condition": {
+        "StringLike": {"s3:prefix": ["home/${aws:username}/*"]},
+        "Bool": {"aws:SecureTransport": true}
+      }
+    },
+    {
+      "Effect": "Deny",
+      "NotAction": "iam:*",
+      "NotResource": "arn:aws:s3:::bucket/*",
+      "Condition": {"ForAnyValue:StringEqualsIfExists": {"aws:RequestTag/Name": "x"}}
+    }
+  ]
+}`,
+			Type: iamPolicyDocumentIdentity,
+		},
+		{
+			Name:     "valid trust policy with single statement object",
+			Document: `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"Service":"ec2.amazonaws.com"}}}`,
+			Type:     iamPolicyDocumentTrust,
+		},
+		{
+			Name:     "valid resource policy",
+			Document: `{"Statement":[{"Effect":"Allow","Action":"sqs:SendMessage","Principal":"*","Resource":"arn:aws:sqs:us-west-2:123456789012:queue","Condition":{"ArnEquals":{"aws:SourceArn":"arn:aws:sns:us-west-2:123456789012:topic"}}}]}`,
+			Type:     iamPolicyDocumentAny,
+		},
+		{
+			Name:     "unknown elements",
+			Document: `{"Version":"2012-10-17","Foo":1,"Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Bar":1}]}`,
+			Errors: []string{
+				`Foo: unsupported policy element`,
+				`Statement[0].Bar: unsupported statement element`,
+			},
+		},
+		{
+			Name:     "bad version and effect",
+			Document: `{"Version":"2012-10-18","Statement":[{"Sid":"One","Effect":"allow","Action":"*","Resource":"*"}]}`,
+			Errors: []string{
+				`Version: must be "2012-10-17" or "2008-10-17", got 2012-10-18`,
+				`Statement[0] (Sid "One").Effect: must be "Allow" or "Deny", got "allow"`,
+			},
+		},
+		{
+			Name:     "action and not action",
+			Document: `{"Statement":[{"Effect":"Allow","Resource":"*"},{"Effect":"Allow","Action":"*","NotAction":"s3:*","Resource":"*"}]}`,
+			Errors: []string{
+				`Statement[0]: one of Action or NotAction is required`,
+				`Statement[1]: only one of Action or NotAction may be specified`,
+			},
+		},
+		{
+			Name:     "malformed action",
+			Document: `{"Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3GetObject",1],"Resource":"*"}]}`,
+			Errors: []string{
+				`Statement[0].Action[1]: must be "*" or of the form "service:action", got "s3GetObject"`,
+				`Statement[0].Action[2]: must be a string`,
+			},
+		},
+		{
+			Name:     "unknown actions",
+			Document: `{"Statement":[{"Effect":"Allow","Action":["s3:GetObjekt","foo:Bar","sqs:Describe*","ec2:Anything"],"Resource":"*"}]}`,
+			Warnings: []string{
+				`Statement[0].Action[0]: unknown s3 action "s3:GetObjekt"`,
+				`Statement[0].Action[1]: unknown service prefix "foo"`,
+				`Statement[0].Action[2]: "sqs:Describe*" matches no known sqs action`,
+			},
+		},
+		{
+			Name:     "identity policy with principal and no resource",
+			Document: `{"Statement":[{"Sid":"a-b","Effect":"Allow","Action":"*","Principal":"*"}]}`,
+			Type:     iamPolicyDocumentIdentity,
+			Errors: []string{
+				`Statement[0] (Sid "a-b").Sid: must only contain alphanumeric characters, got "a-b"`,
+				`Statement[0] (Sid "a-b"): one of Resource or NotResource is required`,
+				`Statement[0] (Sid "a-b").Principal: not supported in identity-based policies`,
+			},
+		},
+		{
+			Name:     "trust policy with resource and no principal",
+			Document: `{"Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Resource":"*"}]}`,
+			Type:     iamPolicyDocumentTrust,
+			Errors: []string{
+				`Statement[0]: one of Principal or NotPrincipal is required`,
+				`Statement[0].Resource: not supported in assume role policies`,
+			},
+		},
+		{
+			Name:     "principals",
+			Document: `{"Statement":[{"Effect":"Allow","Action":"*","Principal":{"AWS":["123456789012","AIDAEXAMPLE"],"User":"x"}}]}`,
+			Errors: []string{
+				`Statement[0].Principal.User: unsupported principal type "User"`,
+			},
+			Warnings: []string{
+				`Statement[0].Principal.AWS[1]: expected "*", an account ID or an ARN, got "AIDAEXAMPLE"`,
+			},
+		},
+		{
+			Name:     "string principal",
+			Document: `{"Statement":[{"Effect":"Allow","Action":"*","Principal":"arn:aws:iam::123456789012:root"}]}`,
+			Errors: []string{
+				`Statement[0].Principal: must be "*" or an object, got "arn:aws:iam::123456789012:root"`,
+			},
+		},
+		{
+			Name:     "resources",
+			Document: `{"Statement":[{"Effect":"Allow","Action":"*","Resource":["bucket","arn:aws:s3","arn:aws-xx:s3:::bucket"]}]}`,
+			Errors: []string{
+				`Statement[0].Resource[0]: must be "*" or an ARN, got "bucket"`,
+				`Statement[0].Resource[1]: invalid ARN "arn:aws:s3"`,
+				`Statement[0].Resource[2]: unknown partition "aws-xx" in ARN "arn:aws-xx:s3:::bucket"`,
+			},
+		},
+		{
+			Name:     "mixed partitions",
+			Document: `{"Statement":[{"Effect":"Allow","Action":"*","Resource":["arn:aws:s3:::a","arn:${AWS::Partition}:s3:::b","arn:aws-cn:s3:::c"]}]}`,
+			Errors: []string{
+				`Statement[0].Resource[2]: ARN "arn:aws-cn:s3:::c" is in the "aws-cn" partition but Statement[0].Resource[0] is in the "aws" partition`,
+			},
+		},
+		{
+			Name:      "wrong partition",
+			Document:  `{"Statement":[{"Effect":"Allow","Action":"*","Resource":"arn:aws:s3:::a"}]}`,
+			Partition: "aws-us-gov",
+			Errors: []string{
+				`Statement[0].Resource[0]: ARN "arn:aws:s3:::a" is not in the "aws-us-gov" partition`,
+			},
+		},
+		{
+			Name:     "conditions",
+			Document: `{"Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringEqualz":{"aws:username":"a"},"NullIfExists":{"s3:x-amz-acl":"true"},"StringEquals":{"aws:Usernam":"a","prefix":"b","foo:bar":"c","accounts.google.com:aud":"d"}}}]}`,
+			Errors: []string{
+				`Statement[0].Condition.NullIfExists: unknown condition operator "NullIfExists"`,
+				`Statement[0].Condition.StringEquals.prefix: condition key must be of the form "prefix:key", got "prefix"`,
+				`Statement[0].Condition.StringEqualz: unknown condition operator "StringEqualz"`,
+			},
+			Warnings: []string{
+				`Statement[0].Condition.StringEquals.aws:Usernam: unknown global condition key "aws:Usernam"`,
+				`Statement[0].Condition.StringEquals.foo:bar: unknown service prefix "foo" in condition key "foo:bar"`,
+			},
+		},
+		{
+			Name:     "missing statement",
+			Document: `{"Version":"2012-10-17"}`,
+			Type:     iamPolicyDocumentIdentity,
+			Errors: []string{
+				`Statement: required`,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		warnings, errors := validateIAMPolicyDocument(tc.Document, tc.Type, tc.Partition)
+
+		var errs []string
+		for _, err := range errors {
+			errs = append(errs, err.Error())
+		}
+		if strings.Join(errs, "\n") != strings.Join(tc.Errors, "\n") {
+			t.Errorf("%s: expected errors:\n%s\ngot:\n%s", tc.Name, strings.Join(tc.Errors, "\n"), strings.Join(errs, "\n"))
+		}
+		if strings.Join(warnings, "\n") != strings.Join(tc.Warnings, "\n") {
+			t.Errorf("%s: expected warnings:\n%s\ngot:\n%s", tc.Name, strings.Join(tc.Warnings, "\n"), strings.Join(warnings, "\n"))
+		}
+	}
+}
+
+func TestIamPolicyConditionOperatorValid(t *testing.T) {
+	cases := map[string]bool{
+		"StringEquals":                     true,
+		"stringequals":                     true,
+		"StringLikeIfExists":               true,
+		"ForAllValues:StringLike":          true,
+		"ForAnyValue:ArnLikeIfExists":      true,
+		"Null":                             true,
+		"NullIfExists":                     false,
+		"ForSomeValues:StringLike":         false,
+		"StringEqualsIfExistsIfExists":     false,
+		"ForAnyValue:ForAllValues:ArnLike": false,
+	}
+
+	for op, expected := range cases {
+		if actual := iamPolicyConditionOperatorValid(op); actual != expected {
+			t.Errorf("%s: expected %t, got %t", op, expected, actual)
+		}
+	}
+}
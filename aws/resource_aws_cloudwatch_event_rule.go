@@ -3,8 +3,6 @@ package aws
 import (
 	"fmt"
 	"log"
-	"regexp"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -96,25 +94,13 @@ func resourceAwsCloudWatchEventRuleCreate(d *schema.ResourceData, meta interface
 	log.Printf("[DEBUG] Creating CloudWatch Event Rule: %s", input)
 
 	// IAM Roles take some time to propagate
-	var out *events.PutRuleOutput
-	err = resource.Retry(30*time.Second, func() *resource.RetryError {
-		var err error
-		out, err = conn.PutRule(input)
-		pattern := regexp.MustCompile("cannot be assumed by principal '[a-z]+\\.amazonaws\\.com'\\.$")
-		if err != nil {
-			if awsErr, ok := err.(awserr.Error); ok {
-				if awsErr.Code() == "ValidationException" && pattern.MatchString(awsErr.Message()) {
-					log.Printf("[DEBUG] Retrying creation of CloudWatch Event Rule %q", *input.Name)
-					return resource.RetryableError(err)
-				}
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
+	resp, err := retryOnIamPropagation(meta, "events", func() (interface{}, error) {
+		return conn.PutRule(input)
 	})
 	if err != nil {
 		return errwrap.Wrapf("Creating CloudWatch Event Rule failed: {{err}}", err)
 	}
+	out := resp.(*events.PutRuleOutput)
 
 	d.Set("arn", out.RuleArn)
 	d.SetId(*input.Name)
@@ -188,19 +174,8 @@ func resourceAwsCloudWatchEventRuleUpdate(d *schema.ResourceData, meta interface
 	log.Printf("[DEBUG] Updating CloudWatch Event Rule: %s", input)
 
 	// IAM Roles take some time to propagate
-	err = resource.Retry(30*time.Second, func() *resource.RetryError {
-		_, err := conn.PutRule(input)
-		pattern := regexp.MustCompile("cannot be assumed by principal '[a-z]+\\.amazonaws\\.com'\\.$")
-		if err != nil {
-			if awsErr, ok := err.(awserr.Error); ok {
-				if awsErr.Code() == "ValidationException" && pattern.MatchString(awsErr.Message()) {
-					log.Printf("[DEBUG] Retrying update of CloudWatch Event Rule %q", *input.Name)
-					return resource.RetryableError(err)
-				}
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
+	_, err = retryOnIamPropagation(meta, "events", func() (interface{}, error) {
+		return conn.PutRule(input)
 	})
 	if err != nil {
 		return errwrap.Wrapf("Updating CloudWatch Event Rule failed: {{err}}", err)
@@ -12,7 +12,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/codebuild"
 	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/hashcode"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 )
@@ -352,31 +351,15 @@ func resourceAwsCodeBuildProjectCreate(d *schema.ResourceData, meta interface{})
 		params.Tags = tagsFromMapCodeBuild(v.(map[string]interface{}))
 	}
 
-	var resp *codebuild.CreateProjectOutput
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-		var err error
-
-		resp, err = conn.CreateProject(params)
-		if err != nil {
-			// Work around eventual consistency of IAM
-			if isAWSErr(err, "InvalidInputException", "CodeBuild is not authorized to perform") {
-				return resource.RetryableError(err)
-			}
-
-			if isAWSErr(err, "InvalidInputException", "Not authorized to perform DescribeSecurityGroups") {
-				return resource.RetryableError(err)
-			}
-
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
-
+	// Work around eventual consistency of IAM, which is slow to reach CodeBuild
+	out, err := retryOnIamPropagationWithMinimum(meta, "codebuild", 5*time.Minute, func() (interface{}, error) {
+		return conn.CreateProject(params)
 	})
 
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating CodeBuild project: %s", err)
 	}
+	resp := out.(*codebuild.CreateProjectOutput)
 
 	d.SetId(*resp.Project.Arn)
 
@@ -656,21 +639,9 @@ func resourceAwsCodeBuildProjectUpdate(d *schema.ResourceData, meta interface{})
 	// But its a slice of pointers so if not set for every update, they get removed.
 	params.Tags = tagsFromMapCodeBuild(d.Get("tags").(map[string]interface{}))
 
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		var err error
-
-		_, err = conn.UpdateProject(params)
-		if err != nil {
-			// Work around eventual consistency of IAM
-			if isAWSErr(err, "InvalidInputException", "CodeBuild is not authorized to perform") {
-				return resource.RetryableError(err)
-			}
-
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
-
+	// Work around eventual consistency of IAM
+	_, err := retryOnIamPropagation(meta, "codebuild", func() (interface{}, error) {
+		return conn.UpdateProject(params)
 	})
 
 	if err != nil {
@@ -0,0 +1,227 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDbClusterSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDbClusterSnapshotCreate,
+		Read:   resourceAwsDbClusterSnapshotRead,
+		Delete: resourceAwsDbClusterSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"db_cluster_snapshot_identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRdsIdentifier,
+			},
+			"db_cluster_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"allocated_storage": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"availability_zones": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"db_cluster_snapshot_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"storage_encrypted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"license_model": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"source_db_cluster_snapshot_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"snapshot_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDbClusterSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	params := &rds.CreateDBClusterSnapshotInput{
+		DBClusterIdentifier:         aws.String(d.Get("db_cluster_identifier").(string)),
+		DBClusterSnapshotIdentifier: aws.String(d.Get("db_cluster_snapshot_identifier").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating RDS DB Cluster Snapshot: %s", params)
+	_, err := conn.CreateDBClusterSnapshot(params)
+	if err != nil {
+		return fmt.Errorf("Error creating RDS DB Cluster Snapshot: %s", err)
+	}
+	d.SetId(d.Get("db_cluster_snapshot_identifier").(string))
+
+	if err := waitForRdsDbClusterSnapshotAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceAwsDbClusterSnapshotRead(d, meta)
+}
+
+func resourceAwsDbClusterSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	snapshot, err := describeRdsDbClusterSnapshot(conn, d.Id())
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		log.Printf("[WARN] RDS DB Cluster Snapshot (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("db_cluster_identifier", snapshot.DBClusterIdentifier)
+	d.Set("db_cluster_snapshot_identifier", snapshot.DBClusterSnapshotIdentifier)
+
+	return dbClusterSnapshotAttributes(d, snapshot)
+}
+
+func resourceAwsDbClusterSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	params := &rds.DeleteDBClusterSnapshotInput{
+		DBClusterSnapshotIdentifier: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting RDS DB Cluster Snapshot: %s", d.Id())
+	_, err := conn.DeleteDBClusterSnapshot(params)
+	if err != nil {
+		if isAWSErr(err, rds.ErrCodeDBClusterSnapshotNotFoundFault, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting RDS DB Cluster Snapshot %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// describeRdsDbClusterSnapshot returns the cluster snapshot with the given
+// identifier, or nil if it does not exist.
+func describeRdsDbClusterSnapshot(conn *rds.RDS, id string) (*rds.DBClusterSnapshot, error) {
+	resp, err := conn.DescribeDBClusterSnapshots(&rds.DescribeDBClusterSnapshotsInput{
+		DBClusterSnapshotIdentifier: aws.String(id),
+	})
+	if err != nil {
+		if isAWSErr(err, rds.ErrCodeDBClusterSnapshotNotFoundFault, "") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Error retrieving RDS DB Cluster Snapshot %s: %s", id, err)
+	}
+
+	if len(resp.DBClusterSnapshots) == 0 {
+		return nil, nil
+	}
+	return resp.DBClusterSnapshots[0], nil
+}
+
+func waitForRdsDbClusterSnapshotAvailable(conn *rds.RDS, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating", "copying"},
+		Target:     []string{"available"},
+		Refresh:    resourceAwsDbClusterSnapshotStateRefreshFunc(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      5 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for RDS DB Cluster Snapshot (%s) to be available", id)
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for RDS DB Cluster Snapshot %s to be available: %s", id, err)
+	}
+	return nil
+}
+
+func resourceAwsDbClusterSnapshotStateRefreshFunc(conn *rds.RDS, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		snapshot, err := describeRdsDbClusterSnapshot(conn, id)
+		if err != nil {
+			return nil, "", err
+		}
+		if snapshot == nil {
+			return nil, "", nil
+		}
+
+		return snapshot, aws.StringValue(snapshot.Status), nil
+	}
+}
+
+// dbClusterSnapshotAttributes sets the computed attributes shared by the
+// cluster snapshot resources and data source.
+func dbClusterSnapshotAttributes(d *schema.ResourceData, snapshot *rds.DBClusterSnapshot) error {
+	if err := d.Set("availability_zones", flattenStringList(snapshot.AvailabilityZones)); err != nil {
+		return fmt.Errorf("Error setting availability_zones: %s", err)
+	}
+	d.Set("allocated_storage", snapshot.AllocatedStorage)
+	d.Set("db_cluster_snapshot_arn", snapshot.DBClusterSnapshotArn)
+	d.Set("engine", snapshot.Engine)
+	d.Set("engine_version", snapshot.EngineVersion)
+	d.Set("kms_key_id", snapshot.KmsKeyId)
+	d.Set("license_model", snapshot.LicenseModel)
+	d.Set("port", snapshot.Port)
+	d.Set("snapshot_type", snapshot.SnapshotType)
+	d.Set("source_db_cluster_snapshot_arn", snapshot.SourceDBClusterSnapshotArn)
+	d.Set("status", snapshot.Status)
+	d.Set("storage_encrypted", snapshot.StorageEncrypted)
+	d.Set("vpc_id", snapshot.VpcId)
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDbClusterSnapshotCopy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDbClusterSnapshotCopyCreate,
+		Read:   resourceAwsDbClusterSnapshotCopyRead,
+		Update: resourceAwsDbClusterSnapshotCopyUpdate,
+		Delete: resourceAwsDbClusterSnapshotDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"source_db_cluster_snapshot_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target_db_cluster_snapshot_identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRdsIdentifier,
+			},
+			"source_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"copy_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"tags": tagsSchema(),
+
+			"allocated_storage": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"availability_zones": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"db_cluster_identifier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"db_cluster_snapshot_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"storage_encrypted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"license_model": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"source_db_cluster_snapshot_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"snapshot_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDbClusterSnapshotCopyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	params := &rds.CopyDBClusterSnapshotInput{
+		SourceDBClusterSnapshotIdentifier: aws.String(d.Get("source_db_cluster_snapshot_identifier").(string)),
+		TargetDBClusterSnapshotIdentifier: aws.String(d.Get("target_db_cluster_snapshot_identifier").(string)),
+		CopyTags:                          aws.Bool(d.Get("copy_tags").(bool)),
+		Tags:                              tagsFromMapRDS(d.Get("tags").(map[string]interface{})),
+	}
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		params.KmsKeyId = aws.String(v.(string))
+	}
+	// Setting the source region makes the SDK generate the presigned URL
+	// needed for cross-region copies.
+	if v, ok := d.GetOk("source_region"); ok {
+		params.SourceRegion = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Copying RDS DB Cluster Snapshot: %s", params)
+	_, err := conn.CopyDBClusterSnapshot(params)
+	if err != nil {
+		return fmt.Errorf("Error copying RDS DB Cluster Snapshot: %s", err)
+	}
+	d.SetId(d.Get("target_db_cluster_snapshot_identifier").(string))
+
+	if err := waitForRdsDbClusterSnapshotAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceAwsDbClusterSnapshotCopyRead(d, meta)
+}
+
+func resourceAwsDbClusterSnapshotCopyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	snapshot, err := describeRdsDbClusterSnapshot(conn, d.Id())
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		log.Printf("[WARN] RDS DB Cluster Snapshot (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("db_cluster_identifier", snapshot.DBClusterIdentifier)
+	d.Set("target_db_cluster_snapshot_identifier", snapshot.DBClusterSnapshotIdentifier)
+
+	if err := saveTagsRDS(conn, d, aws.StringValue(snapshot.DBClusterSnapshotArn)); err != nil {
+		log.Printf("[WARN] Failed to save tags for RDS DB Cluster Snapshot (%s): %s", d.Id(), err)
+	}
+
+	return dbClusterSnapshotAttributes(d, snapshot)
+}
+
+func resourceAwsDbClusterSnapshotCopyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	if err := setTagsRDS(conn, d, d.Get("db_cluster_snapshot_arn").(string)); err != nil {
+		return err
+	}
+
+	return resourceAwsDbClusterSnapshotCopyRead(d, meta)
+}
@@ -0,0 +1,54 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSDBClusterSnapshotCopy_basic(t *testing.T) {
+	var v rds.DBClusterSnapshot
+	rInt := acctest.RandInt()
+	resourceName := "aws_db_cluster_snapshot_copy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDbClusterSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDbClusterSnapshotCopyConfig(rInt, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbClusterSnapshotExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "first"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_db_cluster_snapshot_arn", "aws_db_cluster_snapshot.test", "db_cluster_snapshot_arn"),
+				),
+			},
+			{
+				Config: testAccAwsDbClusterSnapshotCopyConfig(rInt, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbClusterSnapshotExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "second"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsDbClusterSnapshotCopyConfig(rInt int, name string) string {
+	return testAccAwsDbClusterSnapshotConfig(rInt) + fmt.Sprintf(`
+resource "aws_db_cluster_snapshot_copy" "test" {
+  source_db_cluster_snapshot_identifier = "${aws_db_cluster_snapshot.test.db_cluster_snapshot_arn}"
+  target_db_cluster_snapshot_identifier = "tf-acc-test-%d-copy"
+
+  tags {
+    Name = %q
+  }
+}
+`, rInt, name)
+}
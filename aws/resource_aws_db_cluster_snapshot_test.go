@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDBClusterSnapshot_basic(t *testing.T) {
+	var v rds.DBClusterSnapshot
+	rInt := acctest.RandInt()
+	resourceName := "aws_db_cluster_snapshot.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDbClusterSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDbClusterSnapshotConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbClusterSnapshotExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_type", "manual"),
+					resource.TestCheckResourceAttr(resourceName, "engine", "aurora"),
+					resource.TestCheckResourceAttrSet(resourceName, "db_cluster_snapshot_arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestMostRecentDbClusterSnapshot(t *testing.T) {
+	now := time.Now()
+	snapshots := []*rds.DBClusterSnapshot{
+		{DBClusterSnapshotIdentifier: aws.String("old"), SnapshotCreateTime: aws.Time(now.Add(-2 * time.Hour))},
+		{DBClusterSnapshotIdentifier: aws.String("creating")},
+		{DBClusterSnapshotIdentifier: aws.String("new"), SnapshotCreateTime: aws.Time(now)},
+		{DBClusterSnapshotIdentifier: aws.String("middle"), SnapshotCreateTime: aws.Time(now.Add(-time.Hour))},
+	}
+
+	if id := aws.StringValue(mostRecentDbClusterSnapshot(snapshots).DBClusterSnapshotIdentifier); id != "new" {
+		t.Fatalf("expected most recent snapshot to be %q, got %q", "new", id)
+	}
+}
+
+func testAccCheckDbClusterSnapshotExists(n string, v *rds.DBClusterSnapshot) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+
+		snapshot, err := describeRdsDbClusterSnapshot(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if snapshot == nil {
+			return fmt.Errorf("Error finding RDS DB Cluster Snapshot %s", rs.Primary.ID)
+		}
+
+		*v = *snapshot
+		return nil
+	}
+}
+
+func testAccCheckDbClusterSnapshotDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).rdsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_db_cluster_snapshot" && rs.Type != "aws_db_cluster_snapshot_copy" {
+			continue
+		}
+
+		snapshot, err := describeRdsDbClusterSnapshot(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if snapshot != nil {
+			return fmt.Errorf("RDS DB Cluster Snapshot %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsDbClusterSnapshotConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_rds_cluster" "test" {
+  cluster_identifier  = "tf-acc-test-%d"
+  master_username     = "foo"
+  master_password     = "mustbeeightcharaters"
+  skip_final_snapshot = true
+}
+
+resource "aws_db_cluster_snapshot" "test" {
+  db_cluster_identifier          = "${aws_rds_cluster.test.id}"
+  db_cluster_snapshot_identifier = "tf-acc-test-%d"
+}
+`, rInt, rInt)
+}
@@ -332,6 +332,38 @@ func resourceAwsDbInstance() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"restore_to_point_in_time": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				ConflictsWith: []string{
+					"s3_import",
+					"snapshot_identifier",
+					"replicate_source_db",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_db_instance_identifier": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"restore_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateRFC3339TimeString,
+						},
+						"use_latest_restorable_time": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
 			"auto_minor_version_upgrade": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -356,6 +388,19 @@ func resourceAwsDbInstance() *schema.Resource {
 				Default:  0,
 			},
 
+			"performance_insights_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"performance_insights_kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateArn,
+			},
+
 			"option_group_name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -482,6 +527,14 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 			opts.MonitoringInterval = aws.Int64(int64(attr.(int)))
 		}
 
+		if attr, ok := d.GetOk("performance_insights_enabled"); ok {
+			opts.EnablePerformanceInsights = aws.Bool(attr.(bool))
+		}
+
+		if attr, ok := d.GetOk("performance_insights_kms_key_id"); ok {
+			opts.PerformanceInsightsKMSKeyId = aws.String(attr.(string))
+		}
+
 		if attr, ok := d.GetOk("option_group_name"); ok {
 			opts.OptionGroupName = aws.String(attr.(string))
 		}
@@ -601,6 +654,14 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 			opts.MonitoringInterval = aws.Int64(int64(attr.(int)))
 		}
 
+		if attr, ok := d.GetOk("performance_insights_enabled"); ok {
+			opts.EnablePerformanceInsights = aws.Bool(attr.(bool))
+		}
+
+		if attr, ok := d.GetOk("performance_insights_kms_key_id"); ok {
+			opts.PerformanceInsightsKMSKeyId = aws.String(attr.(string))
+		}
+
 		if attr, ok := d.GetOk("option_group_name"); ok {
 			opts.OptionGroupName = aws.String(attr.(string))
 		}
@@ -658,6 +719,116 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 		}
 
 		return resourceAwsDbInstanceRead(d, meta)
+	} else if v, ok := d.GetOk("restore_to_point_in_time"); ok {
+		pointInTime := v.([]interface{})[0].(map[string]interface{})
+		opts := rds.RestoreDBInstanceToPointInTimeInput{
+			AutoMinorVersionUpgrade:    aws.Bool(d.Get("auto_minor_version_upgrade").(bool)),
+			CopyTagsToSnapshot:         aws.Bool(d.Get("copy_tags_to_snapshot").(bool)),
+			DBInstanceClass:            aws.String(d.Get("instance_class").(string)),
+			PubliclyAccessible:         aws.Bool(d.Get("publicly_accessible").(bool)),
+			SourceDBInstanceIdentifier: aws.String(pointInTime["source_db_instance_identifier"].(string)),
+			Tags:                       tags,
+			TargetDBInstanceIdentifier: aws.String(d.Get("identifier").(string)),
+		}
+
+		restoreTime, useLatest, err := expandRdsRestoreTime(pointInTime["restore_time"].(string), pointInTime["use_latest_restorable_time"].(bool))
+		if err != nil {
+			return fmt.Errorf("Error creating DB Instance: %s", err)
+		}
+		opts.RestoreTime = restoreTime
+		opts.UseLatestRestorableTime = useLatest
+
+		if attr, ok := d.GetOk("name"); ok {
+			// DBName doesn't apply to the MySQL, PostgreSQL, or MariaDB engines,
+			// as with RestoreDBInstanceFromDBSnapshot.
+			switch strings.ToLower(d.Get("engine").(string)) {
+			case "mysql", "postgres", "mariadb":
+				// skip
+			default:
+				opts.DBName = aws.String(attr.(string))
+			}
+		}
+
+		if attr, ok := d.GetOk("availability_zone"); ok {
+			opts.AvailabilityZone = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("db_subnet_group_name"); ok {
+			opts.DBSubnetGroupName = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("enabled_cloudwatch_logs_exports"); ok && len(attr.([]interface{})) > 0 {
+			opts.EnableCloudwatchLogsExports = expandStringList(attr.([]interface{}))
+		}
+
+		if attr, ok := d.GetOk("engine"); ok {
+			opts.Engine = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("iam_database_authentication_enabled"); ok {
+			opts.EnableIAMDatabaseAuthentication = aws.Bool(attr.(bool))
+		}
+
+		if attr, ok := d.GetOk("iops"); ok {
+			opts.Iops = aws.Int64(int64(attr.(int)))
+		}
+
+		if attr, ok := d.GetOk("license_model"); ok {
+			opts.LicenseModel = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("multi_az"); ok {
+			opts.MultiAZ = aws.Bool(attr.(bool))
+		}
+
+		if attr, ok := d.GetOk("option_group_name"); ok {
+			opts.OptionGroupName = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("port"); ok {
+			opts.Port = aws.Int64(int64(attr.(int)))
+		}
+
+		if attr, ok := d.GetOk("storage_type"); ok {
+			opts.StorageType = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("tde_credential_arn"); ok {
+			opts.TdeCredentialArn = aws.String(attr.(string))
+		}
+
+		log.Printf("[DEBUG] DB Instance restore to point in time configuration: %s", opts)
+		if _, err := conn.RestoreDBInstanceToPointInTime(&opts); err != nil {
+			return fmt.Errorf("Error creating DB Instance: %s", err)
+		}
+
+		// The restored instance keeps the security groups, parameter group,
+		// password and backup settings of the source instance, so apply the
+		// configured values once it is available.
+		d.SetId(d.Get("identifier").(string))
+
+		log.Printf("[INFO] DB Instance ID: %s", d.Id())
+
+		log.Println(
+			"[INFO] Waiting for DB Instance to be available")
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    resourceAwsDbInstanceCreatePendingStates,
+			Target:     []string{"available", "storage-optimization"},
+			Refresh:    resourceAwsDbInstanceStateRefreshFunc(d.Id(), conn),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			MinTimeout: 10 * time.Second,
+			Delay:      30 * time.Second, // Wait 30 secs before starting
+		}
+
+		// Wait, catching any errors
+		if _, err := stateConf.WaitForState(); err != nil {
+			return err
+		}
+
+		if err := resourceAwsDbInstanceUpdate(d, meta); err != nil {
+			return err
+		}
 	} else if _, ok := d.GetOk("snapshot_identifier"); ok {
 		opts := rds.RestoreDBInstanceFromDBSnapshotInput{
 			DBInstanceClass:         aws.String(d.Get("instance_class").(string)),
@@ -732,18 +903,24 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 
 		var sgUpdate bool
 		var passwordUpdate bool
+		var performanceInsightsUpdate bool
 
 		if _, ok := d.GetOk("password"); ok {
 			passwordUpdate = true
 		}
 
+		// Performance Insights can't be enabled while restoring from a snapshot.
+		if _, ok := d.GetOk("performance_insights_enabled"); ok {
+			performanceInsightsUpdate = true
+		}
+
 		if attr := d.Get("vpc_security_group_ids").(*schema.Set); attr.Len() > 0 {
 			sgUpdate = true
 		}
 		if attr := d.Get("security_group_names").(*schema.Set); attr.Len() > 0 {
 			sgUpdate = true
 		}
-		if sgUpdate || passwordUpdate {
+		if sgUpdate || passwordUpdate || performanceInsightsUpdate {
 			log.Printf("[INFO] DB is restoring from snapshot with default security, but custom security should be set, will now update after snapshot is restored!")
 
 			// wait for instance to get up and then modify security
@@ -881,6 +1058,14 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 			opts.MonitoringInterval = aws.Int64(int64(attr.(int)))
 		}
 
+		if attr, ok := d.GetOk("performance_insights_enabled"); ok {
+			opts.EnablePerformanceInsights = aws.Bool(attr.(bool))
+		}
+
+		if attr, ok := d.GetOk("performance_insights_kms_key_id"); ok {
+			opts.PerformanceInsightsKMSKeyId = aws.String(attr.(string))
+		}
+
 		if attr, ok := d.GetOk("option_group_name"); ok {
 			opts.OptionGroupName = aws.String(attr.(string))
 		}
@@ -1014,6 +1199,9 @@ func resourceAwsDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("monitoring_role_arn", v.MonitoringRoleArn)
 	}
 
+	d.Set("performance_insights_enabled", v.PerformanceInsightsEnabled)
+	d.Set("performance_insights_kms_key_id", v.PerformanceInsightsKMSKeyId)
+
 	if err := d.Set("enabled_cloudwatch_logs_exports", flattenStringList(v.EnabledCloudwatchLogsExports)); err != nil {
 		return fmt.Errorf("error setting enabled_cloudwatch_logs_exports: %s", err)
 	}
@@ -1224,6 +1412,17 @@ func resourceAwsDbInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 		requestUpdate = true
 	}
 
+	if d.HasChange("performance_insights_enabled") || d.HasChange("performance_insights_kms_key_id") {
+		d.SetPartial("performance_insights_enabled")
+		d.SetPartial("performance_insights_kms_key_id")
+		req.EnablePerformanceInsights = aws.Bool(d.Get("performance_insights_enabled").(bool))
+		// The KMS key can only be specified when enabling Performance Insights.
+		if v, ok := d.GetOk("performance_insights_kms_key_id"); ok && *req.EnablePerformanceInsights {
+			req.PerformanceInsightsKMSKeyId = aws.String(v.(string))
+		}
+		requestUpdate = true
+	}
+
 	if d.HasChange("vpc_security_group_ids") {
 		if attr := d.Get("vpc_security_group_ids").(*schema.Set); attr.Len() > 0 {
 			var s []*string
@@ -1480,3 +1679,23 @@ var resourceAwsDbInstanceUpdatePendingStates = []string{
 	"storage-full",
 	"upgrading",
 }
+
+// expandRdsRestoreTime returns the restore time and latest restorable time
+// flag for a point-in-time restore. Exactly one of them must be set.
+func expandRdsRestoreTime(restoreTime string, useLatest bool) (*time.Time, *bool, error) {
+	if restoreTime != "" && useLatest {
+		return nil, nil, fmt.Errorf("only one of restore time or use_latest_restorable_time may be specified")
+	}
+	if useLatest {
+		return nil, aws.Bool(true), nil
+	}
+	if restoreTime == "" {
+		return nil, nil, fmt.Errorf("one of restore time or use_latest_restorable_time must be specified")
+	}
+
+	t, err := time.Parse(time.RFC3339, restoreTime)
+	if err != nil {
+		return nil, nil, err
+	}
+	return aws.Time(t), nil, nil
+}
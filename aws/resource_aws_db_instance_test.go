@@ -319,6 +319,55 @@ func TestAccAWSDBInstance_snapshot(t *testing.T) {
 	})
 }
 
+func TestAccAWSDBInstance_restoreToPointInTime(t *testing.T) {
+	var source, v rds.DBInstance
+	rName := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDBInstanceConfigRestoreToPointInTime(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBInstanceExists("aws_db_instance.bar", &source),
+					testAccCheckAWSDBInstanceExists("aws_db_instance.restore", &v),
+					resource.TestCheckResourceAttr(
+						"aws_db_instance.restore", "restore_to_point_in_time.#", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_db_instance.restore", "backup_retention_period", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestExpandRdsRestoreTime(t *testing.T) {
+	restoreTime, useLatest, err := expandRdsRestoreTime("2018-03-01T10:00:00Z", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if useLatest != nil || restoreTime == nil || restoreTime.Format(time.RFC3339) != "2018-03-01T10:00:00Z" {
+		t.Fatalf("unexpected result: %v, %v", restoreTime, useLatest)
+	}
+
+	restoreTime, useLatest, err = expandRdsRestoreTime("", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if restoreTime != nil || !aws.BoolValue(useLatest) {
+		t.Fatalf("unexpected result: %v, %v", restoreTime, useLatest)
+	}
+
+	if _, _, err := expandRdsRestoreTime("2018-03-01T10:00:00Z", true); err == nil {
+		t.Fatal("expected an error when both are set")
+	}
+	if _, _, err := expandRdsRestoreTime("", false); err == nil {
+		t.Fatal("expected an error when neither is set")
+	}
+}
+
 func TestAccAWSDBInstance_s3(t *testing.T) {
 	var snap rds.DBInstance
 	bucket := acctest.RandomWithPrefix("tf-acc-test")
@@ -393,6 +442,39 @@ func TestAccAWSDBInstance_separate_iops_update(t *testing.T) {
 	})
 }
 
+func TestAccAWSDBInstance_performanceInsights(t *testing.T) {
+	var v rds.DBInstance
+
+	rName := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDBInstanceConfigPerformanceInsights(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBInstanceExists("aws_db_instance.bar", &v),
+					resource.TestCheckResourceAttr(
+						"aws_db_instance.bar", "performance_insights_enabled", "false"),
+				),
+			},
+
+			{
+				Config: testAccAWSDBInstanceConfigPerformanceInsights(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBInstanceExists("aws_db_instance.bar", &v),
+					resource.TestCheckResourceAttr(
+						"aws_db_instance.bar", "performance_insights_enabled", "true"),
+					resource.TestCheckResourceAttrSet(
+						"aws_db_instance.bar", "performance_insights_kms_key_id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSDBInstance_portUpdate(t *testing.T) {
 	var v rds.DBInstance
 
@@ -1337,6 +1419,54 @@ resource "aws_db_instance" "bar" {
 }`, rName, iops)
 }
 
+func testAccAWSDBInstanceConfigPerformanceInsights(rName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_db_instance" "bar" {
+  identifier          = "mydb-rds-%s"
+  engine              = "postgres"
+  engine_version      = "9.6.8"
+  instance_class      = "db.m4.large"
+  name                = "mydb"
+  username            = "foo"
+  password            = "barbarbar"
+  allocated_storage   = 10
+  skip_final_snapshot = true
+
+  apply_immediately            = true
+  performance_insights_enabled = %t
+}`, rName, enabled)
+}
+
+func testAccAWSDBInstanceConfigRestoreToPointInTime(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_db_instance" "bar" {
+  identifier              = "mydb-rds-%s"
+  engine                  = "mysql"
+  engine_version          = "5.6.35"
+  instance_class          = "db.t2.micro"
+  name                    = "mydb"
+  username                = "foo"
+  password                = "barbarbar"
+  allocated_storage       = 10
+  backup_retention_period = 1
+  skip_final_snapshot     = true
+}
+
+resource "aws_db_instance" "restore" {
+  identifier              = "mydb-rds-%s-restore"
+  engine                  = "mysql"
+  instance_class          = "db.t2.micro"
+  backup_retention_period = 3
+  apply_immediately       = true
+  skip_final_snapshot     = true
+
+  restore_to_point_in_time {
+    source_db_instance_identifier = "${aws_db_instance.bar.identifier}"
+    use_latest_restorable_time    = true
+  }
+}`, rName, rName)
+}
+
 func testAccSnapshotInstanceConfig_mysqlPort(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_db_instance" "bar" {
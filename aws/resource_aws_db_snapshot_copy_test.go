@@ -0,0 +1,73 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDBSnapshotCopy_basic(t *testing.T) {
+	var v rds.DBSnapshot
+	rInt := acctest.RandInt()
+	resourceName := "aws_db_snapshot_copy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDbSnapshotCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDbSnapshotCopyConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbSnapshotExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+					resource.TestCheckResourceAttr(resourceName, "engine", "mysql"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "tf-acc-test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDbSnapshotCopyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).rdsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_db_snapshot_copy" {
+			continue
+		}
+
+		resp, err := conn.DescribeDBSnapshots(&rds.DescribeDBSnapshotsInput{
+			DBSnapshotIdentifier: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, rds.ErrCodeDBSnapshotNotFoundFault, "") {
+				continue
+			}
+			return err
+		}
+		if len(resp.DBSnapshots) > 0 {
+			return fmt.Errorf("RDS DB Snapshot %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsDbSnapshotCopyConfig(rInt int) string {
+	return testAccAwsDbSnapshotConfig(rInt) + fmt.Sprintf(`
+resource "aws_db_snapshot_copy" "test" {
+  source_db_snapshot_identifier = "${aws_db_snapshot.test.db_snapshot_arn}"
+  target_db_snapshot_identifier = "testsnapshot%d-copy"
+
+  tags {
+    Name = "tf-acc-test"
+  }
+}
+`, rInt)
+}
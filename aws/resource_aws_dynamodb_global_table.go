@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsDynamoDbGlobalTable() *schema.Resource {
@@ -44,6 +45,55 @@ func resourceAwsDynamoDbGlobalTable() *schema.Resource {
 							Type:     schema.TypeString,
 							Required: true,
 						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"read_capacity": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"write_capacity": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"read_capacity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"write_capacity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"global_secondary_index": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"read_capacity": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"write_capacity": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
 					},
 				},
 			},
@@ -93,6 +143,10 @@ func resourceAwsDynamoDbGlobalTableCreate(d *schema.ResourceData, meta interface
 		return err
 	}
 
+	if err := updateAwsDynamoDbGlobalTableSettings(d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
 	return resourceAwsDynamoDbGlobalTableRead(d, meta)
 }
 
@@ -108,7 +162,16 @@ func resourceAwsDynamoDbGlobalTableRead(d *schema.ResourceData, meta interface{}
 		return nil
 	}
 
-	return flattenAwsDynamoDbGlobalTable(d, globalTableDescription)
+	if err := flattenAwsDynamoDbGlobalTable(d, globalTableDescription); err != nil {
+		return err
+	}
+
+	settings, err := resourceAwsDynamoDbGlobalTableSettingsRetrieve(d, meta)
+	if err != nil {
+		return err
+	}
+
+	return flattenAwsDynamoDbGlobalTableSettings(d, settings)
 }
 
 func resourceAwsDynamoDbGlobalTableUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -165,7 +228,14 @@ func resourceAwsDynamoDbGlobalTableUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	return nil
+	// Replicas added above still need the configured settings applied.
+	if d.HasChange("replica") || d.HasChange("read_capacity") || d.HasChange("write_capacity") || d.HasChange("global_secondary_index") {
+		if err := updateAwsDynamoDbGlobalTableSettings(d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsDynamoDbGlobalTableRead(d, meta)
 }
 
 // Deleting a DynamoDB Global Table is represented by removing all replicas.
@@ -218,6 +288,74 @@ func resourceAwsDynamoDbGlobalTableRetrieve(d *schema.ResourceData, meta interfa
 	return output.GlobalTableDescription, nil
 }
 
+func resourceAwsDynamoDbGlobalTableSettingsRetrieve(d *schema.ResourceData, meta interface{}) ([]*dynamodb.ReplicaSettingsDescription, error) {
+	dynamodbconn := meta.(*AWSClient).dynamodbconn
+
+	input := &dynamodb.DescribeGlobalTableSettingsInput{
+		GlobalTableName: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Retrieving DynamoDB Global Table settings: %#v", input)
+
+	output, err := dynamodbconn.DescribeGlobalTableSettings(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving DynamoDB Global Table settings: %s", err)
+	}
+
+	return output.ReplicaSettings, nil
+}
+
+// updateAwsDynamoDbGlobalTableSettings applies the configured capacity to
+// every replica of the global table and waits for the replicas to settle.
+func updateAwsDynamoDbGlobalTableSettings(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	dynamodbconn := meta.(*AWSClient).dynamodbconn
+
+	input := expandAwsDynamoDbGlobalTableSettings(
+		d.Id(),
+		d.Get("replica").(*schema.Set).List(),
+		d.Get("read_capacity").(int),
+		d.Get("write_capacity").(int),
+		d.Get("global_secondary_index").(*schema.Set).List(),
+	)
+	if input == nil {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Updating DynamoDB Global Table settings: %#v", input)
+	if _, err := dynamodbconn.UpdateGlobalTableSettings(input); err != nil {
+		return fmt.Errorf("Error updating DynamoDB Global Table settings: %s", err)
+	}
+
+	log.Println("[INFO] Waiting for DynamoDB Global Table replicas to be updated")
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			dynamodb.ReplicaStatusCreating,
+			dynamodb.ReplicaStatusDeleting,
+			dynamodb.ReplicaStatusUpdating,
+		},
+		Target: []string{
+			dynamodb.ReplicaStatusActive,
+		},
+		Refresh: func() (interface{}, string, error) {
+			settings, err := resourceAwsDynamoDbGlobalTableSettingsRetrieve(d, meta)
+			if err != nil {
+				return nil, "", err
+			}
+
+			for _, replica := range settings {
+				if status := aws.StringValue(replica.ReplicaStatus); status != dynamodb.ReplicaStatusActive {
+					return settings, status, nil
+				}
+			}
+			return settings, dynamodb.ReplicaStatusActive, nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
+
 func resourceAwsDynamoDbGlobalTableStateRefreshFunc(
 	d *schema.ResourceData, meta interface{}) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -254,6 +392,133 @@ func flattenAwsDynamoDbGlobalTable(d *schema.ResourceData, globalTableDescriptio
 	return nil
 }
 
+// flattenAwsDynamoDbGlobalTableSettings sets the per-replica settings and the
+// table-wide capacity. A table-wide capacity is only reported when all
+// replicas agree on it, so drift in a single region shows up as a diff.
+func flattenAwsDynamoDbGlobalTableSettings(d *schema.ResourceData, settings []*dynamodb.ReplicaSettingsDescription) error {
+	replicas := []interface{}{}
+	readCapacities := make([]int64, 0, len(settings))
+	writeCapacities := make([]int64, 0, len(settings))
+	indexReadCapacities := make(map[string][]int64)
+	indexWriteCapacities := make(map[string][]int64)
+
+	for _, replica := range settings {
+		replicas = append(replicas, map[string]interface{}{
+			"region_name":    aws.StringValue(replica.RegionName),
+			"status":         aws.StringValue(replica.ReplicaStatus),
+			"read_capacity":  int(aws.Int64Value(replica.ReplicaProvisionedReadCapacityUnits)),
+			"write_capacity": int(aws.Int64Value(replica.ReplicaProvisionedWriteCapacityUnits)),
+		})
+		readCapacities = append(readCapacities, aws.Int64Value(replica.ReplicaProvisionedReadCapacityUnits))
+		writeCapacities = append(writeCapacities, aws.Int64Value(replica.ReplicaProvisionedWriteCapacityUnits))
+
+		for _, index := range replica.ReplicaGlobalSecondaryIndexSettings {
+			name := aws.StringValue(index.IndexName)
+			indexReadCapacities[name] = append(indexReadCapacities[name], aws.Int64Value(index.ProvisionedReadCapacityUnits))
+			indexWriteCapacities[name] = append(indexWriteCapacities[name], aws.Int64Value(index.ProvisionedWriteCapacityUnits))
+		}
+	}
+
+	if len(settings) > 0 {
+		if err := d.Set("replica", replicas); err != nil {
+			return err
+		}
+	}
+	d.Set("read_capacity", uniformDynamoDbCapacity(readCapacities))
+	d.Set("write_capacity", uniformDynamoDbCapacity(writeCapacities))
+
+	// Only indexes that are managed here are reported back.
+	indexes := []interface{}{}
+	for _, v := range d.Get("global_secondary_index").(*schema.Set).List() {
+		configured := v.(map[string]interface{})
+		name := configured["name"].(string)
+		index := map[string]interface{}{
+			"name": name,
+		}
+		if configured["read_capacity"].(int) > 0 {
+			index["read_capacity"] = uniformDynamoDbCapacity(indexReadCapacities[name])
+		}
+		if configured["write_capacity"].(int) > 0 {
+			index["write_capacity"] = uniformDynamoDbCapacity(indexWriteCapacities[name])
+		}
+		indexes = append(indexes, index)
+	}
+	return d.Set("global_secondary_index", indexes)
+}
+
+// uniformDynamoDbCapacity returns the capacity shared by all replicas, or 0
+// if the replicas differ.
+func uniformDynamoDbCapacity(capacities []int64) int {
+	if len(capacities) == 0 {
+		return 0
+	}
+	for _, c := range capacities[1:] {
+		if c != capacities[0] {
+			return 0
+		}
+	}
+	return int(capacities[0])
+}
+
+// expandAwsDynamoDbGlobalTableSettings returns the settings update for the
+// configured capacity, or nil if no capacity is configured.
+func expandAwsDynamoDbGlobalTableSettings(globalTableName string, replicas []interface{}, readCapacity, writeCapacity int, indexes []interface{}) *dynamodb.UpdateGlobalTableSettingsInput {
+	input := &dynamodb.UpdateGlobalTableSettingsInput{
+		GlobalTableName: aws.String(globalTableName),
+	}
+	changed := false
+
+	if writeCapacity > 0 {
+		input.GlobalTableProvisionedWriteCapacityUnits = aws.Int64(int64(writeCapacity))
+		changed = true
+	}
+
+	var indexUpdates []*dynamodb.GlobalTableGlobalSecondaryIndexSettingsUpdate
+	var replicaIndexUpdates []*dynamodb.ReplicaGlobalSecondaryIndexSettingsUpdate
+	for _, v := range indexes {
+		index := v.(map[string]interface{})
+		name := index["name"].(string)
+		if c := index["write_capacity"].(int); c > 0 {
+			indexUpdates = append(indexUpdates, &dynamodb.GlobalTableGlobalSecondaryIndexSettingsUpdate{
+				IndexName:                     aws.String(name),
+				ProvisionedWriteCapacityUnits: aws.Int64(int64(c)),
+			})
+		}
+		if c := index["read_capacity"].(int); c > 0 {
+			replicaIndexUpdates = append(replicaIndexUpdates, &dynamodb.ReplicaGlobalSecondaryIndexSettingsUpdate{
+				IndexName:                    aws.String(name),
+				ProvisionedReadCapacityUnits: aws.Int64(int64(c)),
+			})
+		}
+	}
+	if len(indexUpdates) > 0 {
+		input.GlobalTableGlobalSecondaryIndexSettingsUpdate = indexUpdates
+		changed = true
+	}
+
+	if readCapacity > 0 || len(replicaIndexUpdates) > 0 {
+		for _, v := range replicas {
+			replica := v.(map[string]interface{})
+			update := &dynamodb.ReplicaSettingsUpdate{
+				RegionName: aws.String(replica["region_name"].(string)),
+			}
+			if readCapacity > 0 {
+				update.ReplicaProvisionedReadCapacityUnits = aws.Int64(int64(readCapacity))
+			}
+			if len(replicaIndexUpdates) > 0 {
+				update.ReplicaGlobalSecondaryIndexSettingsUpdate = replicaIndexUpdates
+			}
+			input.ReplicaSettingsUpdate = append(input.ReplicaSettingsUpdate, update)
+		}
+		changed = len(input.ReplicaSettingsUpdate) > 0 || changed
+	}
+
+	if !changed {
+		return nil
+	}
+	return input
+}
+
 func expandAwsDynamoDbReplicaUpdateCreateReplicas(configuredReplicas []interface{}) []*dynamodb.ReplicaUpdate {
 	replicaUpdates := make([]*dynamodb.ReplicaUpdate, 0, len(configuredReplicas))
 	for _, replicaRaw := range configuredReplicas {
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"reflect"
 	"regexp"
 	"testing"
 
@@ -89,6 +90,111 @@ func TestAccAWSDynamoDbGlobalTable_multipleRegions(t *testing.T) {
 	})
 }
 
+func TestAccAWSDynamoDbGlobalTable_settings(t *testing.T) {
+	resourceName := "aws_dynamodb_global_table.test"
+	tableName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDynamoDbGlobalTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDynamoDbGlobalTableConfig_settings(tableName, 2, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDynamoDbGlobalTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "read_capacity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "write_capacity", "3"),
+					resource.TestCheckResourceAttr(resourceName, "global_secondary_index.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", "1"),
+				),
+			},
+			{
+				Config: testAccDynamoDbGlobalTableConfig_settings(tableName, 4, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDynamoDbGlobalTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "read_capacity", "4"),
+					resource.TestCheckResourceAttr(resourceName, "write_capacity", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestExpandAwsDynamoDbGlobalTableSettings(t *testing.T) {
+	replicas := []interface{}{
+		map[string]interface{}{"region_name": "us-east-1"},
+		map[string]interface{}{"region_name": "us-west-2"},
+	}
+
+	if input := expandAwsDynamoDbGlobalTableSettings("test", replicas, 0, 0, nil); input != nil {
+		t.Fatalf("Expected no settings update, got: %s", input)
+	}
+
+	indexes := []interface{}{
+		map[string]interface{}{
+			"name":           "test-index",
+			"read_capacity":  3,
+			"write_capacity": 4,
+		},
+	}
+	expected := &dynamodb.UpdateGlobalTableSettingsInput{
+		GlobalTableName:                          aws.String("test"),
+		GlobalTableProvisionedWriteCapacityUnits: aws.Int64(2),
+		GlobalTableGlobalSecondaryIndexSettingsUpdate: []*dynamodb.GlobalTableGlobalSecondaryIndexSettingsUpdate{
+			{
+				IndexName:                     aws.String("test-index"),
+				ProvisionedWriteCapacityUnits: aws.Int64(4),
+			},
+		},
+		ReplicaSettingsUpdate: []*dynamodb.ReplicaSettingsUpdate{
+			{
+				RegionName:                          aws.String("us-east-1"),
+				ReplicaProvisionedReadCapacityUnits: aws.Int64(1),
+				ReplicaGlobalSecondaryIndexSettingsUpdate: []*dynamodb.ReplicaGlobalSecondaryIndexSettingsUpdate{
+					{
+						IndexName:                    aws.String("test-index"),
+						ProvisionedReadCapacityUnits: aws.Int64(3),
+					},
+				},
+			},
+			{
+				RegionName:                          aws.String("us-west-2"),
+				ReplicaProvisionedReadCapacityUnits: aws.Int64(1),
+				ReplicaGlobalSecondaryIndexSettingsUpdate: []*dynamodb.ReplicaGlobalSecondaryIndexSettingsUpdate{
+					{
+						IndexName:                    aws.String("test-index"),
+						ProvisionedReadCapacityUnits: aws.Int64(3),
+					},
+				},
+			},
+		},
+	}
+
+	input := expandAwsDynamoDbGlobalTableSettings("test", replicas, 1, 2, indexes)
+	if !reflect.DeepEqual(input, expected) {
+		t.Fatalf("Given:\n%s\n\nExpected:\n%s", input, expected)
+	}
+}
+
+func TestUniformDynamoDbCapacity(t *testing.T) {
+	testCases := []struct {
+		Capacities []int64
+		Expected   int
+	}{
+		{nil, 0},
+		{[]int64{5}, 5},
+		{[]int64{5, 5, 5}, 5},
+		{[]int64{5, 10}, 0},
+	}
+
+	for i, tc := range testCases {
+		if got := uniformDynamoDbCapacity(tc.Capacities); got != tc.Expected {
+			t.Fatalf("Case #%d: expected %d, got %d", i, tc.Expected, got)
+		}
+	}
+}
+
 func TestAccAWSDynamoDbGlobalTable_import(t *testing.T) {
 	resourceName := "aws_dynamodb_global_table.test"
 	tableName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
@@ -180,6 +286,63 @@ resource "aws_dynamodb_global_table" "test" {
 `, tableName, tableName)
 }
 
+func testAccDynamoDbGlobalTableConfig_settings(tableName string, readCapacity, writeCapacity int) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {
+  current = true
+}
+
+resource "aws_dynamodb_table" "test" {
+  hash_key         = "myAttribute"
+  name             = "%[1]s"
+  stream_enabled   = true
+  stream_view_type = "NEW_AND_OLD_IMAGES"
+  read_capacity    = 1
+  write_capacity   = 1
+
+  attribute {
+    name = "myAttribute"
+    type = "S"
+  }
+
+  attribute {
+    name = "myIndexAttribute"
+    type = "S"
+  }
+
+  global_secondary_index {
+    name            = "myIndex"
+    hash_key        = "myIndexAttribute"
+    read_capacity   = 1
+    write_capacity  = 1
+    projection_type = "KEYS_ONLY"
+  }
+
+  lifecycle {
+    ignore_changes = ["read_capacity", "write_capacity", "global_secondary_index"]
+  }
+}
+
+resource "aws_dynamodb_global_table" "test" {
+  depends_on = ["aws_dynamodb_table.test"]
+
+  name           = "%[1]s"
+  read_capacity  = %[2]d
+  write_capacity = %[3]d
+
+  global_secondary_index {
+    name           = "myIndex"
+    read_capacity  = %[2]d
+    write_capacity = %[3]d
+  }
+
+  replica {
+    region_name = "${data.aws_region.current.name}"
+  }
+}
+`, tableName, readCapacity, writeCapacity)
+}
+
 func testAccDynamoDbGlobalTableConfig_multipleRegions_dynamodb_tables(tableName string) string {
 	return fmt.Sprintf(`
 provider "aws" {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/hashcode"
@@ -84,12 +85,14 @@ func resourceAwsDynamoDbTable() *schema.Resource {
 				ForceNew: true,
 			},
 			"write_capacity": {
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:             schema.TypeInt,
+				Required:         true,
+				DiffSuppressFunc: suppressDynamoDbAutoscaledCapacity("write"),
 			},
 			"read_capacity": {
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:             schema.TypeInt,
+				Required:         true,
+				DiffSuppressFunc: suppressDynamoDbAutoscaledCapacity("read"),
 			},
 			"attribute": {
 				Type:     schema.TypeSet,
@@ -262,6 +265,55 @@ func resourceAwsDynamoDbTable() *schema.Resource {
 					},
 				},
 			},
+			"autoscaling": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
+						"read":  dynamoDbAutoscalingDimensionSchema(),
+						"write": dynamoDbAutoscalingDimensionSchema(),
+						"global_secondary_index": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"read":  dynamoDbAutoscalingDimensionSchema(),
+									"write": dynamoDbAutoscalingDimensionSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"restore_source_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"restore_from_backup_arn"},
+			},
+			"restore_date_time": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validateRFC3339TimeString,
+				ConflictsWith: []string{"restore_from_backup_arn"},
+			},
+			"restore_from_backup_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
 		},
 	}
 }
@@ -276,6 +328,18 @@ func resourceAwsDynamoDbTableCreate(d *schema.ResourceData, meta interface{}) er
 		keySchemaMap["range_key"] = v.(string)
 	}
 
+	if _, ok := d.GetOk("restore_date_time"); ok {
+		if _, ok := d.GetOk("restore_source_name"); !ok {
+			return fmt.Errorf("restore_source_name must be set when restore_date_time is specified")
+		}
+	}
+
+	_, restoreFromTable := d.GetOk("restore_source_name")
+	_, restoreFromBackup := d.GetOk("restore_from_backup_arn")
+	if restoreFromTable || restoreFromBackup {
+		return resourceAwsDynamoDbTableRestore(d, meta)
+	}
+
 	log.Printf("[DEBUG] Creating DynamoDB table with key schema: %#v", keySchemaMap)
 
 	req := &dynamodb.CreateTableInput{
@@ -358,6 +422,129 @@ func resourceAwsDynamoDbTableCreate(d *schema.ResourceData, meta interface{}) er
 	return resourceAwsDynamoDbTableUpdate(d, meta)
 }
 
+// resourceAwsDynamoDbTableRestore creates the table from a backup or a point
+// in time of another table. Restored tables keep the indexes and capacity of
+// the source and have no streams, TTL, tags or point-in-time recovery, so the
+// configured values are applied once the table is active.
+func resourceAwsDynamoDbTableRestore(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+	tableName := d.Get("name").(string)
+
+	var table *dynamodb.TableDescription
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		var err error
+		if v, ok := d.GetOk("restore_from_backup_arn"); ok {
+			input := &dynamodb.RestoreTableFromBackupInput{
+				BackupArn:       aws.String(v.(string)),
+				TargetTableName: aws.String(tableName),
+			}
+			log.Printf("[DEBUG] Restoring DynamoDB table from backup: %s", input)
+			var output *dynamodb.RestoreTableFromBackupOutput
+			output, err = conn.RestoreTableFromBackup(input)
+			if err == nil {
+				table = output.TableDescription
+			}
+		} else {
+			input := &dynamodb.RestoreTableToPointInTimeInput{
+				SourceTableName: aws.String(d.Get("restore_source_name").(string)),
+				TargetTableName: aws.String(tableName),
+			}
+			if v, ok := d.GetOk("restore_date_time"); ok {
+				t, _ := time.Parse(time.RFC3339, v.(string))
+				input.RestoreDateTime = aws.Time(t)
+			} else {
+				input.UseLatestRestorableTime = aws.Bool(true)
+			}
+			log.Printf("[DEBUG] Restoring DynamoDB table to point in time: %s", input)
+			var output *dynamodb.RestoreTableToPointInTimeOutput
+			output, err = conn.RestoreTableToPointInTime(input)
+			if err == nil {
+				table = output.TableDescription
+			}
+		}
+		if err != nil {
+			if isAWSErr(err, "ThrottlingException", "") {
+				return resource.RetryableError(err)
+			}
+			if isAWSErr(err, dynamodb.ErrCodeLimitExceededException, "can be created, updated, or deleted simultaneously") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error restoring DynamoDB table %s: %s", tableName, err)
+	}
+
+	d.SetId(*table.TableName)
+	d.Set("arn", table.TableArn)
+
+	if err := waitForDynamoDbTableToBeActive(d.Id(), d.Timeout(schema.TimeoutCreate), conn); err != nil {
+		return err
+	}
+
+	result, err := conn.DescribeTable(&dynamodb.DescribeTableInput{
+		TableName: aws.String(d.Id()),
+	})
+	if err != nil {
+		return err
+	}
+	table = result.Table
+
+	readCapacity := int64(d.Get("read_capacity").(int))
+	writeCapacity := int64(d.Get("write_capacity").(int))
+	if aws.Int64Value(table.ProvisionedThroughput.ReadCapacityUnits) != readCapacity ||
+		aws.Int64Value(table.ProvisionedThroughput.WriteCapacityUnits) != writeCapacity {
+		_, err := conn.UpdateTable(&dynamodb.UpdateTableInput{
+			TableName: aws.String(d.Id()),
+			ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
+				ReadCapacityUnits:  aws.Int64(readCapacity),
+				WriteCapacityUnits: aws.Int64(writeCapacity),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating capacity of restored DynamoDB table %s: %s", d.Id(), err)
+		}
+		if err := waitForDynamoDbTableToBeActive(d.Id(), d.Timeout(schema.TimeoutCreate), conn); err != nil {
+			return err
+		}
+	}
+
+	ops, err := diffDynamoDbGSI(flattenDynamoDbGlobalSecondaryIndexes(table.GlobalSecondaryIndexes), d.Get("global_secondary_index").(*schema.Set).List())
+	if err != nil {
+		return fmt.Errorf("Computing difference for global_secondary_index failed: %s", err)
+	}
+	if len(ops) > 0 {
+		log.Printf("[DEBUG] Updating global secondary indexes of restored table:\n%s", ops)
+		input := &dynamodb.UpdateTableInput{
+			TableName:            aws.String(d.Id()),
+			AttributeDefinitions: expandDynamoDbAttributes(d.Get("attribute").(*schema.Set).List()),
+		}
+		if err := updateDynamoDbGSIs(input, ops, d.Timeout(schema.TimeoutCreate), conn); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("stream_enabled").(bool) {
+		_, err := conn.UpdateTable(&dynamodb.UpdateTableInput{
+			TableName: aws.String(d.Id()),
+			StreamSpecification: &dynamodb.StreamSpecification{
+				StreamEnabled:  aws.Bool(true),
+				StreamViewType: aws.String(d.Get("stream_view_type").(string)),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("Error enabling stream on restored DynamoDB table %s: %s", d.Id(), err)
+		}
+		if err := waitForDynamoDbTableToBeActive(d.Id(), d.Timeout(schema.TimeoutCreate), conn); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsDynamoDbTableUpdate(d, meta)
+}
+
 func resourceAwsDynamoDbTableUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).dynamodbconn
 
@@ -418,44 +605,14 @@ func resourceAwsDynamoDbTableUpdate(d *schema.ResourceData, meta interface{}) er
 			AttributeDefinitions: expandDynamoDbAttributes(attributes),
 		}
 
-		// Only 1 online index can be created or deleted simultaneously per table
-		for _, op := range ops {
-			input.GlobalSecondaryIndexUpdates = []*dynamodb.GlobalSecondaryIndexUpdate{op}
-			log.Printf("[DEBUG] Updating DynamoDB Table: %s", input)
-			_, err := conn.UpdateTable(input)
-			if err != nil {
-				return err
-			}
-			if op.Create != nil {
-				idxName := *op.Create.IndexName
-				if err := waitForDynamoDbGSIToBeActive(d.Id(), idxName, conn); err != nil {
-					return fmt.Errorf("Error waiting for DynamoDB GSI %q to be created: %s", idxName, err)
-				}
-			}
-			if op.Update != nil {
-				idxName := *op.Update.IndexName
-				if err := waitForDynamoDbGSIToBeActive(d.Id(), idxName, conn); err != nil {
-					return fmt.Errorf("Error waiting for DynamoDB GSI %q to be updated: %s", idxName, err)
-				}
-			}
-			if op.Delete != nil {
-				idxName := *op.Delete.IndexName
-				if err := waitForDynamoDbGSIToBeDeleted(d.Id(), idxName, conn); err != nil {
-					return fmt.Errorf("Error waiting for DynamoDB GSI %q to be deleted: %s", idxName, err)
-				}
-			}
-		}
-
-		// We may only be changing the attribute type
-		if len(ops) == 0 {
-			_, err := conn.UpdateTable(input)
-			if err != nil {
-				return err
-			}
+		if err := updateDynamoDbGSIs(input, ops, d.Timeout(schema.TimeoutUpdate), conn); err != nil {
+			return err
 		}
+	}
 
-		if err := waitForDynamoDbTableToBeActive(d.Id(), d.Timeout(schema.TimeoutUpdate), conn); err != nil {
-			return fmt.Errorf("Error waiting for DynamoDB Table op: %s", err)
+	if d.HasChange("autoscaling") {
+		if err := updateDynamoDbAutoscaling(d, meta); err != nil {
+			return err
 		}
 	}
 
@@ -532,12 +689,29 @@ func resourceAwsDynamoDbTableRead(d *schema.ResourceData, meta interface{}) erro
 	}
 	d.Set("point_in_time_recovery", flattenDynamoDbPitr(pitrOut))
 
+	if v := d.Get("autoscaling").([]interface{}); len(v) > 0 && v[0] != nil {
+		autoscaling, err := readDynamoDbAutoscaling(d, meta)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("autoscaling", autoscaling); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 func resourceAwsDynamoDbTableDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).dynamodbconn
 
+	targets := expandDynamoDbAutoscalingTargets(d.Id(), d.Get("autoscaling").([]interface{}))
+	for _, target := range targets {
+		if err := deregisterDynamoDbAutoscalingTarget(target, meta); err != nil {
+			return err
+		}
+	}
+
 	log.Printf("[DEBUG] DynamoDB delete table: %s", d.Id())
 
 	err := deleteAwsDynamoDbTable(d.Id(), conn)
@@ -604,6 +778,54 @@ func deleteAwsDynamoDbTable(tableName string, conn *dynamodb.DynamoDB) error {
 	})
 }
 
+// updateDynamoDbGSIs applies the global secondary index updates one at a
+// time, as only one online index can be created or deleted simultaneously.
+func updateDynamoDbGSIs(input *dynamodb.UpdateTableInput, ops []*dynamodb.GlobalSecondaryIndexUpdate, timeout time.Duration, conn *dynamodb.DynamoDB) error {
+	tableName := aws.StringValue(input.TableName)
+
+	// Only 1 online index can be created or deleted simultaneously per table
+	for _, op := range ops {
+		input.GlobalSecondaryIndexUpdates = []*dynamodb.GlobalSecondaryIndexUpdate{op}
+		log.Printf("[DEBUG] Updating DynamoDB Table: %s", input)
+		_, err := conn.UpdateTable(input)
+		if err != nil {
+			return err
+		}
+		if op.Create != nil {
+			idxName := *op.Create.IndexName
+			if err := waitForDynamoDbGSIToBeActive(tableName, idxName, conn); err != nil {
+				return fmt.Errorf("Error waiting for DynamoDB GSI %q to be created: %s", idxName, err)
+			}
+		}
+		if op.Update != nil {
+			idxName := *op.Update.IndexName
+			if err := waitForDynamoDbGSIToBeActive(tableName, idxName, conn); err != nil {
+				return fmt.Errorf("Error waiting for DynamoDB GSI %q to be updated: %s", idxName, err)
+			}
+		}
+		if op.Delete != nil {
+			idxName := *op.Delete.IndexName
+			if err := waitForDynamoDbGSIToBeDeleted(tableName, idxName, conn); err != nil {
+				return fmt.Errorf("Error waiting for DynamoDB GSI %q to be deleted: %s", idxName, err)
+			}
+		}
+	}
+
+	// We may only be changing the attribute type
+	if len(ops) == 0 {
+		_, err := conn.UpdateTable(input)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := waitForDynamoDbTableToBeActive(tableName, timeout, conn); err != nil {
+		return fmt.Errorf("Error waiting for DynamoDB Table op: %s", err)
+	}
+
+	return nil
+}
+
 func updateDynamoDbTimeToLive(d *schema.ResourceData, conn *dynamodb.DynamoDB) error {
 	toBeEnabled := false
 	attributeName := ""
@@ -888,3 +1110,325 @@ func isDynamoDbTableOptionDisabled(v interface{}) bool {
 	e := options[0].(map[string]interface{})["enabled"]
 	return !e.(bool)
 }
+
+func dynamoDbAutoscalingDimensionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"min_capacity": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"max_capacity": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"target_utilization": {
+					Type:     schema.TypeFloat,
+					Optional: true,
+					Default:  70,
+				},
+			},
+		},
+	}
+}
+
+// suppressDynamoDbAutoscaledCapacity ignores changes to the table capacity
+// once auto scaling manages the given dimension; the configured value is
+// only used when the table is created.
+func suppressDynamoDbAutoscaledCapacity(dimension string) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if d.Id() == "" {
+			return false
+		}
+		v, ok := d.GetOk("autoscaling.0." + dimension)
+		return ok && len(v.([]interface{})) > 0
+	}
+}
+
+// dynamoDbAutoscaledIndexCapacity returns the previously known capacity of an
+// index whose capacity is managed by auto scaling, so that the changes made
+// by the scaling policies do not show up as differences.
+func dynamoDbAutoscaledIndexCapacity(d *schema.ResourceData, indexName, key string) (int, bool) {
+	dimension := "read"
+	if key == "write_capacity" {
+		dimension = "write"
+	}
+
+	autoscaled := false
+	if v, ok := d.GetOk("autoscaling.0.global_secondary_index"); ok {
+		for _, raw := range v.(*schema.Set).List() {
+			index := raw.(map[string]interface{})
+			if index["name"].(string) == indexName && len(index[dimension].([]interface{})) > 0 {
+				autoscaled = true
+			}
+		}
+	}
+	if !autoscaled {
+		return 0, false
+	}
+
+	for _, raw := range d.Get("global_secondary_index").(*schema.Set).List() {
+		gsi := raw.(map[string]interface{})
+		if gsi["name"].(string) == indexName && gsi[key].(int) > 0 {
+			return gsi[key].(int), true
+		}
+	}
+	return 0, false
+}
+
+type dynamoDbAutoscalingTarget struct {
+	resourceID        string
+	dimension         string
+	metricType        string
+	roleARN           string
+	minCapacity       int
+	maxCapacity       int
+	targetUtilization float64
+}
+
+func (t *dynamoDbAutoscalingTarget) policyName() string {
+	return fmt.Sprintf("%s:%s", t.metricType, t.resourceID)
+}
+
+// expandDynamoDbAutoscalingTargets returns the scalable targets described by
+// the autoscaling block, keyed by resource ID and scalable dimension.
+func expandDynamoDbAutoscalingTargets(tableName string, configured []interface{}) map[string]*dynamoDbAutoscalingTarget {
+	targets := make(map[string]*dynamoDbAutoscalingTarget)
+	if len(configured) == 0 || configured[0] == nil {
+		return targets
+	}
+	autoscaling := configured[0].(map[string]interface{})
+	roleARN := autoscaling["role_arn"].(string)
+
+	add := func(resourceID, kind string, read, write []interface{}) {
+		dimensions := []struct {
+			configured []interface{}
+			dimension  string
+			metricType string
+		}{
+			{read, fmt.Sprintf("dynamodb:%s:ReadCapacityUnits", kind), applicationautoscaling.MetricTypeDynamoDbreadCapacityUtilization},
+			{write, fmt.Sprintf("dynamodb:%s:WriteCapacityUnits", kind), applicationautoscaling.MetricTypeDynamoDbwriteCapacityUtilization},
+		}
+		for _, dim := range dimensions {
+			if len(dim.configured) == 0 || dim.configured[0] == nil {
+				continue
+			}
+			m := dim.configured[0].(map[string]interface{})
+			targets[resourceID+":"+dim.dimension] = &dynamoDbAutoscalingTarget{
+				resourceID:        resourceID,
+				dimension:         dim.dimension,
+				metricType:        dim.metricType,
+				roleARN:           roleARN,
+				minCapacity:       m["min_capacity"].(int),
+				maxCapacity:       m["max_capacity"].(int),
+				targetUtilization: m["target_utilization"].(float64),
+			}
+		}
+	}
+
+	tableResourceID := fmt.Sprintf("table/%s", tableName)
+	add(tableResourceID, "table", autoscaling["read"].([]interface{}), autoscaling["write"].([]interface{}))
+
+	if v, ok := autoscaling["global_secondary_index"].(*schema.Set); ok {
+		for _, raw := range v.List() {
+			index := raw.(map[string]interface{})
+			indexResourceID := fmt.Sprintf("%s/index/%s", tableResourceID, index["name"].(string))
+			add(indexResourceID, "index", index["read"].([]interface{}), index["write"].([]interface{}))
+		}
+	}
+
+	return targets
+}
+
+// updateDynamoDbAutoscaling registers the configured scalable targets and
+// their target tracking policies, and deregisters the ones no longer
+// configured. Deregistering a target also deletes its policies.
+func updateDynamoDbAutoscaling(d *schema.ResourceData, meta interface{}) error {
+	o, n := d.GetChange("autoscaling")
+	oldTargets := expandDynamoDbAutoscalingTargets(d.Id(), o.([]interface{}))
+	newTargets := expandDynamoDbAutoscalingTargets(d.Id(), n.([]interface{}))
+
+	for key, target := range oldTargets {
+		if _, ok := newTargets[key]; !ok {
+			if err := deregisterDynamoDbAutoscalingTarget(target, meta); err != nil {
+				return err
+			}
+		}
+	}
+
+	for key, target := range newTargets {
+		if old, ok := oldTargets[key]; ok && *old == *target {
+			continue
+		}
+		if err := putDynamoDbAutoscalingTarget(target, meta); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func putDynamoDbAutoscalingTarget(target *dynamoDbAutoscalingTarget, meta interface{}) error {
+	conn := meta.(*AWSClient).appautoscalingconn
+
+	targetInput := &applicationautoscaling.RegisterScalableTargetInput{
+		ResourceId:        aws.String(target.resourceID),
+		ScalableDimension: aws.String(target.dimension),
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceDynamodb),
+		MinCapacity:       aws.Int64(int64(target.minCapacity)),
+		MaxCapacity:       aws.Int64(int64(target.maxCapacity)),
+	}
+	if target.roleARN != "" {
+		targetInput.RoleARN = aws.String(target.roleARN)
+	}
+
+	log.Printf("[DEBUG] Registering DynamoDB auto scaling target: %s", targetInput)
+	_, err := retryOnIamPropagation(meta, "application-autoscaling", func() (interface{}, error) {
+		return conn.RegisterScalableTarget(targetInput)
+	})
+	if err != nil {
+		return fmt.Errorf("Error registering DynamoDB auto scaling target %s (%s): %s", target.resourceID, target.dimension, err)
+	}
+
+	policyInput := &applicationautoscaling.PutScalingPolicyInput{
+		PolicyName:        aws.String(target.policyName()),
+		PolicyType:        aws.String(applicationautoscaling.PolicyTypeTargetTrackingScaling),
+		ResourceId:        aws.String(target.resourceID),
+		ScalableDimension: aws.String(target.dimension),
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceDynamodb),
+		TargetTrackingScalingPolicyConfiguration: &applicationautoscaling.TargetTrackingScalingPolicyConfiguration{
+			PredefinedMetricSpecification: &applicationautoscaling.PredefinedMetricSpecification{
+				PredefinedMetricType: aws.String(target.metricType),
+			},
+			TargetValue: aws.Float64(target.targetUtilization),
+		},
+	}
+
+	log.Printf("[DEBUG] Putting DynamoDB auto scaling policy: %s", policyInput)
+	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.PutScalingPolicy(policyInput)
+		if err != nil {
+			if isAWSErr(err, applicationautoscaling.ErrCodeFailedResourceAccessException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error putting DynamoDB auto scaling policy %s: %s", target.policyName(), err)
+	}
+
+	return nil
+}
+
+func deregisterDynamoDbAutoscalingTarget(target *dynamoDbAutoscalingTarget, meta interface{}) error {
+	conn := meta.(*AWSClient).appautoscalingconn
+
+	log.Printf("[DEBUG] Deregistering DynamoDB auto scaling target %s (%s)", target.resourceID, target.dimension)
+	_, err := conn.DeregisterScalableTarget(&applicationautoscaling.DeregisterScalableTargetInput{
+		ResourceId:        aws.String(target.resourceID),
+		ScalableDimension: aws.String(target.dimension),
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceDynamodb),
+	})
+	if err != nil {
+		if isAWSErr(err, applicationautoscaling.ErrCodeObjectNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deregistering DynamoDB auto scaling target %s (%s): %s", target.resourceID, target.dimension, err)
+	}
+
+	return nil
+}
+
+// readDynamoDbAutoscaling returns the autoscaling block as currently
+// registered. Dimensions whose scalable target no longer exists are left
+// out, so they are registered again on the next apply.
+func readDynamoDbAutoscaling(d *schema.ResourceData, meta interface{}) ([]interface{}, error) {
+	conn := meta.(*AWSClient).appautoscalingconn
+
+	configured := d.Get("autoscaling").([]interface{})[0].(map[string]interface{})
+	tableResourceID := fmt.Sprintf("table/%s", d.Id())
+
+	read := func(resourceID, kind, dimension string, metricType string) ([]interface{}, error) {
+		scalableDimension := fmt.Sprintf("dynamodb:%s:%sCapacityUnits", kind, dimension)
+		target, err := getAwsAppautoscalingTarget(resourceID, applicationautoscaling.ServiceNamespaceDynamodb, scalableDimension, conn)
+		if err != nil {
+			return nil, err
+		}
+		if target == nil {
+			log.Printf("[WARN] DynamoDB auto scaling target %s (%s) not found", resourceID, scalableDimension)
+			return []interface{}{}, nil
+		}
+
+		m := map[string]interface{}{
+			"min_capacity":       int(aws.Int64Value(target.MinCapacity)),
+			"max_capacity":       int(aws.Int64Value(target.MaxCapacity)),
+			"target_utilization": float64(0),
+		}
+
+		policies, err := conn.DescribeScalingPolicies(&applicationautoscaling.DescribeScalingPoliciesInput{
+			PolicyNames:       []*string{aws.String(fmt.Sprintf("%s:%s", metricType, resourceID))},
+			ResourceId:        aws.String(resourceID),
+			ScalableDimension: aws.String(scalableDimension),
+			ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceDynamodb),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving DynamoDB auto scaling policy for %s (%s): %s", resourceID, scalableDimension, err)
+		}
+		for _, policy := range policies.ScalingPolicies {
+			if policy.TargetTrackingScalingPolicyConfiguration != nil {
+				m["target_utilization"] = aws.Float64Value(policy.TargetTrackingScalingPolicyConfiguration.TargetValue)
+			}
+		}
+
+		return []interface{}{m}, nil
+	}
+
+	readDimensions := func(resourceID, kind string, in map[string]interface{}, out map[string]interface{}) error {
+		if len(in["read"].([]interface{})) > 0 {
+			v, err := read(resourceID, kind, "Read", applicationautoscaling.MetricTypeDynamoDbreadCapacityUtilization)
+			if err != nil {
+				return err
+			}
+			out["read"] = v
+		}
+		if len(in["write"].([]interface{})) > 0 {
+			v, err := read(resourceID, kind, "Write", applicationautoscaling.MetricTypeDynamoDbwriteCapacityUtilization)
+			if err != nil {
+				return err
+			}
+			out["write"] = v
+		}
+		return nil
+	}
+
+	autoscaling := map[string]interface{}{
+		"role_arn": configured["role_arn"],
+	}
+	if err := readDimensions(tableResourceID, "table", configured, autoscaling); err != nil {
+		return nil, err
+	}
+
+	indexes := []interface{}{}
+	for _, raw := range configured["global_secondary_index"].(*schema.Set).List() {
+		in := raw.(map[string]interface{})
+		out := map[string]interface{}{
+			"name": in["name"],
+		}
+		indexResourceID := fmt.Sprintf("%s/index/%s", tableResourceID, in["name"].(string))
+		if err := readDimensions(indexResourceID, "index", in, out); err != nil {
+			return nil, err
+		}
+		indexes = append(indexes, out)
+	}
+	autoscaling["global_secondary_index"] = indexes
+
+	return []interface{}{autoscaling}, nil
+}
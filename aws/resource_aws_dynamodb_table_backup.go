@@ -0,0 +1,192 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsDynamoDbTableBackup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDynamoDbTableBackupCreate,
+		Read:   resourceAwsDynamoDbTableBackupRead,
+		Update: resourceAwsDynamoDbTableBackupUpdate,
+		Delete: resourceAwsDynamoDbTableBackupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.-]{3,255}$`), "must be 3-255 alphanumeric characters, underscores, periods or hyphens"),
+			},
+			"retain_on_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"creation_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"table_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"table_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDynamoDbTableBackupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	input := &dynamodb.CreateBackupInput{
+		BackupName: aws.String(d.Get("name").(string)),
+		TableName:  aws.String(d.Get("table_name").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating DynamoDB table backup: %s", input)
+	var output *dynamodb.CreateBackupOutput
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		var err error
+		output, err = conn.CreateBackup(input)
+		if err != nil {
+			// Backups can't be taken while the table is being created or updated
+			if isAWSErr(err, dynamodb.ErrCodeTableInUseException, "") {
+				return resource.RetryableError(err)
+			}
+			if isAWSErr(err, dynamodb.ErrCodeLimitExceededException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating DynamoDB table backup: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.BackupDetails.BackupArn))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{dynamodb.BackupStatusCreating},
+		Target:  []string{dynamodb.BackupStatusAvailable},
+		Timeout: d.Timeout(schema.TimeoutCreate),
+		Refresh: func() (interface{}, string, error) {
+			result, err := conn.DescribeBackup(&dynamodb.DescribeBackupInput{
+				BackupArn: aws.String(d.Id()),
+			})
+			if err != nil {
+				return 42, "", err
+			}
+
+			return result, aws.StringValue(result.BackupDescription.BackupDetails.BackupStatus), nil
+		},
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for DynamoDB table backup %s to be available: %s", d.Id(), err)
+	}
+
+	return resourceAwsDynamoDbTableBackupRead(d, meta)
+}
+
+func resourceAwsDynamoDbTableBackupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	result, err := conn.DescribeBackup(&dynamodb.DescribeBackupInput{
+		BackupArn: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, dynamodb.ErrCodeBackupNotFoundException, "") {
+			log.Printf("[WARN] DynamoDB table backup (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading DynamoDB table backup %s: %s", d.Id(), err)
+	}
+
+	details := result.BackupDescription.BackupDetails
+	if aws.StringValue(details.BackupStatus) == dynamodb.BackupStatusDeleted {
+		log.Printf("[WARN] DynamoDB table backup (%s) has been deleted, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", details.BackupArn)
+	d.Set("name", details.BackupName)
+	d.Set("status", details.BackupStatus)
+	d.Set("size_bytes", details.BackupSizeBytes)
+	if details.BackupCreationDateTime != nil {
+		d.Set("creation_date_time", details.BackupCreationDateTime.Format(time.RFC3339))
+	}
+
+	if source := result.BackupDescription.SourceTableDetails; source != nil {
+		d.Set("table_name", source.TableName)
+		d.Set("table_arn", source.TableArn)
+		d.Set("table_id", source.TableId)
+	}
+
+	return nil
+}
+
+func resourceAwsDynamoDbTableBackupUpdate(d *schema.ResourceData, meta interface{}) error {
+	// retain_on_delete only affects deletion, so there is nothing to update remotely.
+	return resourceAwsDynamoDbTableBackupRead(d, meta)
+}
+
+func resourceAwsDynamoDbTableBackupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	if d.Get("retain_on_delete").(bool) {
+		log.Printf("[DEBUG] Retaining DynamoDB table backup %s, removing from state only", d.Id())
+		return nil
+	}
+
+	log.Printf("[DEBUG] Deleting DynamoDB table backup: %s", d.Id())
+	_, err := conn.DeleteBackup(&dynamodb.DeleteBackupInput{
+		BackupArn: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, dynamodb.ErrCodeBackupNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting DynamoDB table backup %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
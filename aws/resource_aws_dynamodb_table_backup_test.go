@@ -0,0 +1,165 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDynamoDbTableBackup_basic(t *testing.T) {
+	var conf dynamodb.BackupDescription
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_dynamodb_table_backup.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDynamoDbTableBackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDynamoDbTableBackupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDynamoDbTableBackupExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "table_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", dynamodb.BackupStatusAvailable),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`:table/`+rName+`/backup/`)),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date_time"),
+					resource.TestCheckResourceAttrPair(resourceName, "table_arn", "aws_dynamodb_table.test", "arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retain_on_delete"},
+			},
+		},
+	})
+}
+
+func TestAccAWSDynamoDbTableBackup_restore(t *testing.T) {
+	var conf dynamodb.DescribeTableOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDynamoDbTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDynamoDbTableBackupConfig_restore(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInitialAWSDynamoDbTableExists("aws_dynamodb_table.restored", &conf),
+					resource.TestCheckResourceAttr("aws_dynamodb_table.restored", "name", rName+"-restored"),
+					resource.TestCheckResourceAttr("aws_dynamodb_table.restored", "read_capacity", "2"),
+					resource.TestCheckResourceAttr("aws_dynamodb_table.restored", "stream_enabled", "true"),
+					resource.TestCheckResourceAttr("aws_dynamodb_table.restored", "tags.%", "1"),
+					resource.TestCheckResourceAttr("aws_dynamodb_table.restored", "tags.Name", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDynamoDbTableBackupExists(n string, backup *dynamodb.BackupDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DynamoDB table backup ARN is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+		resp, err := conn.DescribeBackup(&dynamodb.DescribeBackupInput{
+			BackupArn: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*backup = *resp.BackupDescription
+
+		return nil
+	}
+}
+
+func testAccCheckAWSDynamoDbTableBackupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dynamodb_table_backup" {
+			continue
+		}
+
+		resp, err := conn.DescribeBackup(&dynamodb.DescribeBackupInput{
+			BackupArn: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, dynamodb.ErrCodeBackupNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		status := aws.StringValue(resp.BackupDescription.BackupDetails.BackupStatus)
+		if status != dynamodb.BackupStatusDeleted {
+			return fmt.Errorf("DynamoDB table backup %s still exists with status %s", rs.Primary.ID, status)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSDynamoDbTableBackupConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name = "%[1]s"
+  read_capacity = 1
+  write_capacity = 1
+  hash_key = "TestTableHashKey"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_table_backup" "test" {
+  table_name = "${aws_dynamodb_table.test.name}"
+  name = "%[1]s"
+}
+`, rName)
+}
+
+func testAccAWSDynamoDbTableBackupConfig_restore(rName string) string {
+	return testAccAWSDynamoDbTableBackupConfig(rName) + fmt.Sprintf(`
+resource "aws_dynamodb_table" "restored" {
+  name = "%[1]s-restored"
+  read_capacity = 2
+  write_capacity = 1
+  hash_key = "TestTableHashKey"
+  restore_from_backup_arn = "${aws_dynamodb_table_backup.test.arn}"
+  stream_enabled = true
+  stream_view_type = "KEYS_ONLY"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  tags {
+    Name = "%[1]s"
+  }
+}
+`, rName)
+}
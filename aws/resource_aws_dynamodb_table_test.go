@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
@@ -12,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -331,6 +333,141 @@ func TestDiffDynamoDbGSI(t *testing.T) {
 	}
 }
 
+func TestFlattenDynamoDbGlobalSecondaryIndexes(t *testing.T) {
+	gsis := []*dynamodb.GlobalSecondaryIndexDescription{
+		{
+			IndexName: aws.String("att1-index"),
+			KeySchema: []*dynamodb.KeySchemaElement{
+				{
+					AttributeName: aws.String("att1"),
+					KeyType:       aws.String("HASH"),
+				},
+			},
+			ProvisionedThroughput: &dynamodb.ProvisionedThroughputDescription{
+				WriteCapacityUnits: aws.Int64(10),
+				ReadCapacityUnits:  aws.Int64(10),
+			},
+			Projection: &dynamodb.Projection{
+				ProjectionType: aws.String("ALL"),
+			},
+		},
+		{
+			IndexName: aws.String("att2-index"),
+			KeySchema: []*dynamodb.KeySchemaElement{
+				{
+					AttributeName: aws.String("att2"),
+					KeyType:       aws.String("HASH"),
+				},
+				{
+					AttributeName: aws.String("att3"),
+					KeyType:       aws.String("RANGE"),
+				},
+			},
+			ProvisionedThroughput: &dynamodb.ProvisionedThroughputDescription{
+				WriteCapacityUnits: aws.Int64(5),
+				ReadCapacityUnits:  aws.Int64(5),
+			},
+			Projection: &dynamodb.Projection{
+				ProjectionType:   aws.String("INCLUDE"),
+				NonKeyAttributes: aws.StringSlice([]string{"att4"}),
+			},
+		},
+	}
+
+	config := []interface{}{
+		map[string]interface{}{
+			"name":               "att1-index",
+			"hash_key":           "att1",
+			"range_key":          "",
+			"write_capacity":     10,
+			"read_capacity":      10,
+			"projection_type":    "ALL",
+			"non_key_attributes": []interface{}{},
+		},
+		map[string]interface{}{
+			"name":               "att2-index",
+			"hash_key":           "att2",
+			"range_key":          "att3",
+			"write_capacity":     5,
+			"read_capacity":      5,
+			"projection_type":    "INCLUDE",
+			"non_key_attributes": []interface{}{"att4"},
+		},
+	}
+
+	flattened := flattenDynamoDbGlobalSecondaryIndexes(gsis)
+	if !reflect.DeepEqual(flattened, config) {
+		t.Fatalf("Given:\n%#v\n\nExpected:\n%#v", flattened, config)
+	}
+
+	ops, err := diffDynamoDbGSI(flattened, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ops) != 0 {
+		t.Fatalf("Expected no updates, given: %s", ops)
+	}
+}
+
+func TestExpandDynamoDbAutoscalingTargets(t *testing.T) {
+	dimension := func(min, max int, target float64) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"min_capacity":       min,
+				"max_capacity":       max,
+				"target_utilization": target,
+			},
+		}
+	}
+	indexes := schema.NewSet(schema.HashResource(resourceAwsDynamoDbTable().Schema["autoscaling"].Elem.(*schema.Resource).Schema["global_secondary_index"].Elem.(*schema.Resource)), []interface{}{
+		map[string]interface{}{
+			"name":  "test-index",
+			"read":  []interface{}{},
+			"write": dimension(2, 20, 50),
+		},
+	})
+	configured := []interface{}{
+		map[string]interface{}{
+			"role_arn":               "",
+			"read":                   dimension(1, 10, 70),
+			"write":                  []interface{}{},
+			"global_secondary_index": indexes,
+		},
+	}
+
+	expected := map[string]*dynamoDbAutoscalingTarget{
+		"table/test:dynamodb:table:ReadCapacityUnits": {
+			resourceID:        "table/test",
+			dimension:         "dynamodb:table:ReadCapacityUnits",
+			metricType:        "DynamoDBReadCapacityUtilization",
+			minCapacity:       1,
+			maxCapacity:       10,
+			targetUtilization: 70,
+		},
+		"table/test/index/test-index:dynamodb:index:WriteCapacityUnits": {
+			resourceID:        "table/test/index/test-index",
+			dimension:         "dynamodb:index:WriteCapacityUnits",
+			metricType:        "DynamoDBWriteCapacityUtilization",
+			minCapacity:       2,
+			maxCapacity:       20,
+			targetUtilization: 50,
+		},
+	}
+
+	targets := expandDynamoDbAutoscalingTargets("test", configured)
+	if !reflect.DeepEqual(targets, expected) {
+		t.Fatalf("Given:\n%#v\n\nExpected:\n%#v", targets, expected)
+	}
+
+	if name := targets["table/test:dynamodb:table:ReadCapacityUnits"].policyName(); name != "DynamoDBReadCapacityUtilization:table/test" {
+		t.Fatalf("Unexpected policy name: %s", name)
+	}
+
+	if targets := expandDynamoDbAutoscalingTargets("test", []interface{}{}); len(targets) != 0 {
+		t.Fatalf("Expected no targets, got: %#v", targets)
+	}
+}
+
 func TestAccAWSDynamoDbTable_basic(t *testing.T) {
 	var conf dynamodb.DescribeTableOutput
 
@@ -412,6 +549,72 @@ func TestAccAWSDynamoDbTable_enablePitr(t *testing.T) {
 	})
 }
 
+func TestAccAWSDynamoDbTable_restoreToPointInTime(t *testing.T) {
+	var conf dynamodb.DescribeTableOutput
+
+	rName := acctest.RandomWithPrefix("TerraformTestTable-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDynamoDbTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDynamoDbConfig_backup(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDynamoDbTableHasPointInTimeRecoveryEnabled("aws_dynamodb_table.basic-dynamodb-table"),
+				),
+			},
+			{
+				Config: testAccAWSDynamoDbConfig_restoreToPointInTime(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInitialAWSDynamoDbTableExists("aws_dynamodb_table.restored", &conf),
+					resource.TestCheckResourceAttr("aws_dynamodb_table.restored", "name", rName+"-restored"),
+					resource.TestCheckResourceAttr("aws_dynamodb_table.restored", "restore_source_name", rName),
+					resource.TestCheckResourceAttr("aws_dynamodb_table.restored", "global_secondary_index.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDynamoDbTable_autoscaling(t *testing.T) {
+	var conf dynamodb.DescribeTableOutput
+
+	rName := acctest.RandomWithPrefix("TerraformTestTable-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDynamoDbTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDynamoDbConfigAutoscaling(rName, 5, 70),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInitialAWSDynamoDbTableExists("aws_dynamodb_table.basic-dynamodb-table", &conf),
+					resource.TestCheckResourceAttr("aws_dynamodb_table.basic-dynamodb-table", "autoscaling.#", "1"),
+					resource.TestCheckResourceAttr("aws_dynamodb_table.basic-dynamodb-table", "autoscaling.0.read.0.max_capacity", "5"),
+					resource.TestCheckResourceAttr("aws_dynamodb_table.basic-dynamodb-table", "autoscaling.0.write.0.target_utilization", "70"),
+					resource.TestCheckResourceAttr("aws_dynamodb_table.basic-dynamodb-table", "autoscaling.0.global_secondary_index.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSDynamoDbConfigAutoscaling(rName, 10, 50),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_dynamodb_table.basic-dynamodb-table", "autoscaling.0.read.0.max_capacity", "10"),
+					resource.TestCheckResourceAttr("aws_dynamodb_table.basic-dynamodb-table", "autoscaling.0.write.0.target_utilization", "50"),
+				),
+			},
+			{
+				Config: testAccAWSDynamoDbConfigAutoscalingTable(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_dynamodb_table.basic-dynamodb-table", "autoscaling.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSDynamoDbTable_streamSpecification(t *testing.T) {
 	var conf dynamodb.DescribeTableOutput
 
@@ -1121,6 +1324,92 @@ resource "aws_dynamodb_table" "basic-dynamodb-table" {
 `, rName)
 }
 
+func testAccAWSDynamoDbConfig_restoreToPointInTime(rName string) string {
+	return testAccAWSDynamoDbConfig_backup(rName) + fmt.Sprintf(`
+resource "aws_dynamodb_table" "restored" {
+  name = "%[1]s-restored"
+  read_capacity = 1
+  write_capacity = 1
+  hash_key = "TestTableHashKey"
+  restore_source_name = "${aws_dynamodb_table.basic-dynamodb-table.name}"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "TestGSIHashKey"
+    type = "S"
+  }
+
+  global_secondary_index {
+    name = "TestGSI"
+    hash_key = "TestGSIHashKey"
+    write_capacity = 1
+    read_capacity = 1
+    projection_type = "KEYS_ONLY"
+  }
+}
+`, rName)
+}
+
+func testAccAWSDynamoDbConfigAutoscaling(rName string, maxCapacity int, targetUtilization float64) string {
+	return testAccAWSDynamoDbConfigAutoscalingTable(rName, fmt.Sprintf(`
+  autoscaling {
+    read {
+      min_capacity = 1
+      max_capacity = %[1]d
+    }
+
+    write {
+      min_capacity = 1
+      max_capacity = %[1]d
+      target_utilization = %[2]g
+    }
+
+    global_secondary_index {
+      name = "TestGSI"
+
+      read {
+        min_capacity = 1
+        max_capacity = %[1]d
+        target_utilization = %[2]g
+      }
+    }
+  }
+`, maxCapacity, targetUtilization))
+}
+
+func testAccAWSDynamoDbConfigAutoscalingTable(rName, autoscaling string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "basic-dynamodb-table" {
+  name = "%s"
+  read_capacity = 1
+  write_capacity = 1
+  hash_key = "TestTableHashKey"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "TestGSIHashKey"
+    type = "S"
+  }
+
+  global_secondary_index {
+    name = "TestGSI"
+    hash_key = "TestGSIHashKey"
+    write_capacity = 1
+    read_capacity = 1
+    projection_type = "KEYS_ONLY"
+  }
+%s}
+`, rName, autoscaling)
+}
+
 func testAccAWSDynamoDbConfigInitialState(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_dynamodb_table" "basic-dynamodb-table" {
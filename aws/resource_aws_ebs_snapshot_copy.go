@@ -0,0 +1,160 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsEbsSnapshotCopy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEbsSnapshotCopyCreate,
+		Read:   resourceAwsEbsSnapshotRead,
+		Update: resourceAwsEbsSnapshotCopyUpdate,
+		Delete: resourceAwsEbsSnapshotDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(40 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"source_snapshot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_region": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"encrypted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"volume_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner_alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"volume_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"data_encryption_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsEbsSnapshotCopyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	request := &ec2.CopySnapshotInput{
+		SourceRegion:     aws.String(d.Get("source_region").(string)),
+		SourceSnapshotId: aws.String(d.Get("source_snapshot_id").(string)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		request.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("encrypted"); ok {
+		request.Encrypted = aws.Bool(v.(bool))
+	}
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		request.KmsKeyId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Copying EBS snapshot: %s", request)
+	res, err := conn.CopySnapshot(request)
+	if err != nil {
+		return fmt.Errorf("Error copying EBS snapshot %s from %s: %s",
+			d.Get("source_snapshot_id").(string), d.Get("source_region").(string), err)
+	}
+
+	d.SetId(aws.StringValue(res.SnapshotId))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.SnapshotStatePending},
+		Target:     []string{ec2.SnapshotStateCompleted},
+		Refresh:    resourceAwsEbsSnapshotCopyStateRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for EBS snapshot copy (%s) to complete: %s", d.Id(), err)
+	}
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("Error setting tags on EBS snapshot copy (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsEbsSnapshotRead(d, meta)
+}
+
+func resourceAwsEbsSnapshotCopyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("Error updating tags on EBS snapshot copy (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsEbsSnapshotRead(d, meta)
+}
+
+func resourceAwsEbsSnapshotCopyStateRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeSnapshots(&ec2.DescribeSnapshotsInput{
+			SnapshotIds: []*string{aws.String(id)},
+		})
+		if err != nil {
+			// The copy may not be visible yet right after CopySnapshot returns.
+			if isAWSErr(err, "InvalidSnapshot.NotFound", "") {
+				return nil, ec2.SnapshotStatePending, nil
+			}
+			return nil, "", err
+		}
+
+		if resp == nil || len(resp.Snapshots) == 0 {
+			return nil, ec2.SnapshotStatePending, nil
+		}
+
+		snapshot := resp.Snapshots[0]
+		state := aws.StringValue(snapshot.State)
+		if state == ec2.SnapshotStateError {
+			return snapshot, state, fmt.Errorf("EBS snapshot copy failed: %s", aws.StringValue(snapshot.StateMessage))
+		}
+
+		return snapshot, state, nil
+	}
+}
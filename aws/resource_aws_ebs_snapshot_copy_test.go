@@ -0,0 +1,285 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEbsSnapshotCopy_basic(t *testing.T) {
+	var v ec2.Snapshot
+	rName := fmt.Sprintf("tf-acc-ebs-snapshot-copy-basic-%s", acctest.RandString(7))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEbsSnapshotCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsEbsSnapshotCopyConfig(rName, "one"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotExists("aws_ebs_snapshot_copy.test", &v),
+					testAccCheckTags(&v.Tags, "Name", rName),
+					testAccCheckTags(&v.Tags, "Step", "one"),
+				),
+			},
+			{
+				Config: testAccAwsEbsSnapshotCopyConfig(rName, "two"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotExists("aws_ebs_snapshot_copy.test", &v),
+					testAccCheckTags(&v.Tags, "Step", "two"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEbsSnapshotCopy_withDescription(t *testing.T) {
+	var v ec2.Snapshot
+	rName := fmt.Sprintf("tf-acc-ebs-snapshot-copy-desc-%s", acctest.RandString(7))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEbsSnapshotCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsEbsSnapshotCopyConfigWithDescription(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotExists("aws_ebs_snapshot_copy.test", &v),
+					resource.TestCheckResourceAttr("aws_ebs_snapshot_copy.test", "description", rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEbsSnapshotCopy_withRegions(t *testing.T) {
+	var v ec2.Snapshot
+	rName := fmt.Sprintf("tf-acc-ebs-snapshot-copy-regions-%s", acctest.RandString(7))
+
+	// record the initialized providers so that we can use them to
+	// check for the snapshots in each region
+	var providers []*schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccMultipleRegionsPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckEbsSnapshotCopyDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsEbsSnapshotCopyConfigWithRegions(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotExistsWithProvider("aws_ebs_snapshot_copy.test", &v,
+						testAccAwsRegionProviderFunc("us-east-1", &providers)),
+					resource.TestCheckResourceAttr("aws_ebs_snapshot_copy.test", "source_region", "us-west-2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEbsSnapshotCopy_withKms(t *testing.T) {
+	var v ec2.Snapshot
+	rName := fmt.Sprintf("tf-acc-ebs-snapshot-copy-kms-%s", acctest.RandString(7))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEbsSnapshotCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsEbsSnapshotCopyConfigWithKms(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotExists("aws_ebs_snapshot_copy.test", &v),
+					resource.TestCheckResourceAttr("aws_ebs_snapshot_copy.test", "encrypted", "true"),
+					resource.TestMatchResourceAttr("aws_ebs_snapshot_copy.test", "kms_key_id",
+						regexp.MustCompile("^arn:aws:kms:[a-z]{2}-[a-z]+-\\d{1}:[0-9]{12}:key/[a-z0-9-]{36}$")),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEbsSnapshotCopyDestroy(s *terraform.State) error {
+	return testAccCheckEbsSnapshotCopyDestroyWithProvider(s, testAccProvider)
+}
+
+func testAccCheckEbsSnapshotCopyDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	conn := provider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ebs_snapshot_copy" {
+			continue
+		}
+
+		resp, err := conn.DescribeSnapshots(&ec2.DescribeSnapshotsInput{
+			SnapshotIds: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			if isAWSErr(err, "InvalidSnapshot.NotFound", "") {
+				continue
+			}
+			return err
+		}
+
+		if len(resp.Snapshots) > 0 {
+			return fmt.Errorf("EBS snapshot copy %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSnapshotExistsWithProvider(n string, v *ec2.Snapshot, providerF func() *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		provider := providerF()
+		conn := provider.Meta().(*AWSClient).ec2conn
+
+		response, err := conn.DescribeSnapshots(&ec2.DescribeSnapshotsInput{
+			SnapshotIds: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err == nil {
+			if response.Snapshots != nil && len(response.Snapshots) > 0 {
+				*v = *response.Snapshots[0]
+				return nil
+			}
+		}
+		return fmt.Errorf("Error finding EC2 Snapshot %s", rs.Primary.ID)
+	}
+}
+
+func testAccAwsEbsSnapshotCopyConfig(rName, step string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_ebs_volume" "test" {
+  availability_zone = "${data.aws_region.current.name}a"
+  size              = 1
+}
+
+resource "aws_ebs_snapshot" "test" {
+  volume_id = "${aws_ebs_volume.test.id}"
+}
+
+resource "aws_ebs_snapshot_copy" "test" {
+  source_snapshot_id = "${aws_ebs_snapshot.test.id}"
+  source_region      = "${data.aws_region.current.name}"
+
+  tags {
+    Name = "%s"
+    Step = "%s"
+  }
+}
+`, rName, step)
+}
+
+func testAccAwsEbsSnapshotCopyConfigWithDescription(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_ebs_volume" "test" {
+  availability_zone = "${data.aws_region.current.name}a"
+  size              = 1
+}
+
+resource "aws_ebs_snapshot" "test" {
+  volume_id = "${aws_ebs_volume.test.id}"
+}
+
+resource "aws_ebs_snapshot_copy" "test" {
+  source_snapshot_id = "${aws_ebs_snapshot.test.id}"
+  source_region      = "${data.aws_region.current.name}"
+  description        = "%s"
+}
+`, rName)
+}
+
+func testAccAwsEbsSnapshotCopyConfigWithRegions(rName string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-west-2"
+  alias  = "uswest2"
+}
+
+provider "aws" {
+  region = "us-east-1"
+  alias  = "useast1"
+}
+
+resource "aws_ebs_volume" "test" {
+  provider          = "aws.uswest2"
+  availability_zone = "us-west-2a"
+  size              = 1
+}
+
+resource "aws_ebs_snapshot" "test" {
+  provider  = "aws.uswest2"
+  volume_id = "${aws_ebs_volume.test.id}"
+}
+
+resource "aws_ebs_snapshot_copy" "test" {
+  provider           = "aws.useast1"
+  source_snapshot_id = "${aws_ebs_snapshot.test.id}"
+  source_region      = "us-west-2"
+
+  tags {
+    Name = "%s"
+  }
+}
+`, rName)
+}
+
+func testAccAwsEbsSnapshotCopyConfigWithKms(rName string) string {
+	return fmt.Sprintf(`
+variable "name" { default = "%s" }
+data "aws_region" "current" {}
+
+resource "aws_kms_key" "test" {
+  deletion_window_in_days = 7
+
+  tags {
+    Name = "${var.name}"
+  }
+}
+
+resource "aws_ebs_volume" "test" {
+  availability_zone = "${data.aws_region.current.name}a"
+  size              = 1
+}
+
+resource "aws_ebs_snapshot" "test" {
+  volume_id = "${aws_ebs_volume.test.id}"
+}
+
+resource "aws_ebs_snapshot_copy" "test" {
+  source_snapshot_id = "${aws_ebs_snapshot.test.id}"
+  source_region      = "${data.aws_region.current.name}"
+  encrypted          = true
+  kms_key_id         = "${aws_kms_key.test.arn}"
+
+  tags {
+    Name = "${var.name}"
+  }
+}
+`, rName)
+}
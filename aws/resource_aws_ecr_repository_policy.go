@@ -2,12 +2,10 @@ package aws
 
 import (
 	"log"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ecr"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -47,22 +45,14 @@ func resourceAwsEcrRepositoryPolicyCreate(d *schema.ResourceData, meta interface
 	log.Printf("[DEBUG] Creating ECR resository policy: %s", input)
 
 	// Retry due to IAM eventual consistency
-	var out *ecr.SetRepositoryPolicyOutput
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-		var err error
-		out, err = conn.SetRepositoryPolicy(&input)
-
-		if isAWSErr(err, "InvalidParameterException", "Invalid repository policy provided") {
-			return resource.RetryableError(err)
-
-		}
-		return resource.NonRetryableError(err)
+	out, err := retryOnIamPropagation(meta, "ecr", func() (interface{}, error) {
+		return conn.SetRepositoryPolicy(&input)
 	})
 	if err != nil {
 		return err
 	}
 
-	repositoryPolicy := *out
+	repositoryPolicy := *out.(*ecr.SetRepositoryPolicyOutput)
 
 	log.Printf("[DEBUG] ECR repository policy created: %s", *repositoryPolicy.RepositoryName)
 
@@ -119,22 +109,14 @@ func resourceAwsEcrRepositoryPolicyUpdate(d *schema.ResourceData, meta interface
 	log.Printf("[DEBUG] Updating ECR resository policy: %s", input)
 
 	// Retry due to IAM eventual consistency
-	var out *ecr.SetRepositoryPolicyOutput
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-		var err error
-		out, err = conn.SetRepositoryPolicy(&input)
-
-		if isAWSErr(err, "InvalidParameterException", "Invalid repository policy provided") {
-			return resource.RetryableError(err)
-
-		}
-		return resource.NonRetryableError(err)
+	out, err := retryOnIamPropagation(meta, "ecr", func() (interface{}, error) {
+		return conn.SetRepositoryPolicy(&input)
 	})
 	if err != nil {
 		return err
 	}
 
-	repositoryPolicy := *out
+	repositoryPolicy := *out.(*ecr.SetRepositoryPolicyOutput)
 
 	d.SetId(*repositoryPolicy.RepositoryName)
 	d.Set("registry_id", repositoryPolicy.RegistryId)
@@ -396,14 +396,14 @@ func resourceAwsEcsServiceCreate(d *schema.ResourceData, meta interface{}) error
 	// Retry due to AWS IAM & ECS eventual consistency
 	var out *ecs.CreateServiceOutput
 	var err error
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.Retry(meta.(*AWSClient).iamPropagationTimeout(), func() *resource.RetryError {
 		out, err = conn.CreateService(&input)
 
 		if err != nil {
 			if isAWSErr(err, ecs.ErrCodeClusterNotFoundException, "") {
 				return resource.RetryableError(err)
 			}
-			if isAWSErr(err, ecs.ErrCodeInvalidParameterException, "Please verify that the ECS service role being passed has the proper permissions.") {
+			if isIamPropagationErr("ecs", err) {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -734,21 +734,13 @@ func resourceAwsEcsServiceUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	// Retry due to IAM eventual consistency
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-		out, err := conn.UpdateService(&input)
-		if err != nil {
-			if isAWSErr(err, ecs.ErrCodeInvalidParameterException, "Please verify that the ECS service role being passed has the proper permissions.") {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		log.Printf("[DEBUG] Updated ECS service %s", out.Service)
-		return nil
+	out, err := retryOnIamPropagation(meta, "ecs", func() (interface{}, error) {
+		return conn.UpdateService(&input)
 	})
 	if err != nil {
 		return err
 	}
+	log.Printf("[DEBUG] Updated ECS service %s", out.(*ecs.UpdateServiceOutput).Service)
 
 	return resourceAwsEcsServiceRead(d, meta)
 }
@@ -0,0 +1,304 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsElasticacheSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsElasticacheSnapshotCreate,
+		Read:   resourceAwsElasticacheSnapshotRead,
+		Delete: resourceAwsElasticacheSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"snapshot_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"replication_group_id"},
+			},
+			"replication_group_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cluster_id"},
+			},
+			"export": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_bucket_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"target_snapshot_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"node_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"num_cache_nodes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"num_node_groups": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"snapshot_create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"snapshot_source": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsElasticacheSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	params := &elasticache.CreateSnapshotInput{
+		SnapshotName: aws.String(d.Get("snapshot_name").(string)),
+	}
+	if v, ok := d.GetOk("cluster_id"); ok {
+		params.CacheClusterId = aws.String(v.(string))
+	} else if v, ok := d.GetOk("replication_group_id"); ok {
+		params.ReplicationGroupId = aws.String(v.(string))
+	} else {
+		return fmt.Errorf("One of cluster_id or replication_group_id must be set")
+	}
+
+	log.Printf("[DEBUG] Creating ElastiCache Snapshot: %s", params)
+	_, err := conn.CreateSnapshot(params)
+	if err != nil {
+		return fmt.Errorf("Error creating ElastiCache Snapshot: %s", err)
+	}
+	d.SetId(d.Get("snapshot_name").(string))
+
+	if err := waitForElasticacheSnapshotAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	if v, ok := d.GetOk("export"); ok {
+		export := v.([]interface{})[0].(map[string]interface{})
+		copyParams := &elasticache.CopySnapshotInput{
+			SourceSnapshotName: aws.String(d.Id()),
+			TargetSnapshotName: aws.String(export["target_snapshot_name"].(string)),
+			TargetBucket:       aws.String(export["s3_bucket_name"].(string)),
+		}
+
+		log.Printf("[DEBUG] Exporting ElastiCache Snapshot: %s", copyParams)
+		if _, err := conn.CopySnapshot(copyParams); err != nil {
+			return fmt.Errorf("Error exporting ElastiCache Snapshot %s: %s", d.Id(), err)
+		}
+
+		if err := waitForElasticacheSnapshotAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsElasticacheSnapshotRead(d, meta)
+}
+
+func resourceAwsElasticacheSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	snapshot, err := describeElasticacheSnapshot(conn, d.Id())
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		log.Printf("[WARN] ElastiCache Snapshot (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("snapshot_name", snapshot.SnapshotName)
+
+	return elasticacheSnapshotAttributes(d, snapshot, meta)
+}
+
+func resourceAwsElasticacheSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	log.Printf("[DEBUG] Deleting ElastiCache Snapshot: %s", d.Id())
+	_, err := conn.DeleteSnapshot(&elasticache.DeleteSnapshotInput{
+		SnapshotName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, elasticache.ErrCodeSnapshotNotFoundFault, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting ElastiCache Snapshot %s: %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"available", "deleting"},
+		Target:     []string{},
+		Refresh:    resourceAwsElasticacheSnapshotStateRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for ElastiCache Snapshot %s to be deleted: %s", d.Id(), err)
+	}
+	return nil
+}
+
+// describeElasticacheSnapshot returns the snapshot with the given name, or
+// nil if it does not exist.
+func describeElasticacheSnapshot(conn *elasticache.ElastiCache, name string) (*elasticache.Snapshot, error) {
+	resp, err := conn.DescribeSnapshots(&elasticache.DescribeSnapshotsInput{
+		SnapshotName: aws.String(name),
+	})
+	if err != nil {
+		if isAWSErr(err, elasticache.ErrCodeSnapshotNotFoundFault, "") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Error retrieving ElastiCache Snapshot %s: %s", name, err)
+	}
+
+	if len(resp.Snapshots) == 0 {
+		return nil, nil
+	}
+	return resp.Snapshots[0], nil
+}
+
+func waitForElasticacheSnapshotAvailable(conn *elasticache.ElastiCache, name string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating", "copying", "exporting"},
+		Target:     []string{"available"},
+		Refresh:    resourceAwsElasticacheSnapshotStateRefreshFunc(conn, name),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      5 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for ElastiCache Snapshot (%s) to be available", name)
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for ElastiCache Snapshot %s to be available: %s", name, err)
+	}
+	return nil
+}
+
+func resourceAwsElasticacheSnapshotStateRefreshFunc(conn *elasticache.ElastiCache, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		snapshot, err := describeElasticacheSnapshot(conn, name)
+		if err != nil {
+			return nil, "", err
+		}
+		if snapshot == nil {
+			return nil, "", nil
+		}
+
+		return snapshot, aws.StringValue(snapshot.SnapshotStatus), nil
+	}
+}
+
+// elasticacheSnapshotAttributes sets the computed attributes shared by the
+// snapshot resource and data source.
+func elasticacheSnapshotAttributes(d *schema.ResourceData, snapshot *elasticache.Snapshot, meta interface{}) error {
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "elasticache",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("snapshot:%s", aws.StringValue(snapshot.SnapshotName)),
+	}.String()
+	d.Set("arn", arn)
+
+	d.Set("cluster_id", snapshot.CacheClusterId)
+	d.Set("replication_group_id", snapshot.ReplicationGroupId)
+	d.Set("engine", snapshot.Engine)
+	d.Set("engine_version", snapshot.EngineVersion)
+	d.Set("node_type", snapshot.CacheNodeType)
+	d.Set("num_cache_nodes", snapshot.NumCacheNodes)
+	d.Set("num_node_groups", snapshot.NumNodeGroups)
+	d.Set("port", snapshot.Port)
+	d.Set("snapshot_source", snapshot.SnapshotSource)
+	d.Set("status", snapshot.SnapshotStatus)
+	d.Set("vpc_id", snapshot.VpcId)
+
+	if t := elasticacheSnapshotCreateTime(snapshot); t != nil {
+		d.Set("snapshot_create_time", t.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// elasticacheSnapshotCreateTime returns the time the first node snapshot was
+// taken, or nil while the snapshot is still being created.
+func elasticacheSnapshotCreateTime(snapshot *elasticache.Snapshot) *time.Time {
+	var createTime *time.Time
+	for _, node := range snapshot.NodeSnapshots {
+		if node.SnapshotCreateTime == nil {
+			continue
+		}
+		if createTime == nil || node.SnapshotCreateTime.Before(*createTime) {
+			createTime = node.SnapshotCreateTime
+		}
+	}
+	return createTime
+}
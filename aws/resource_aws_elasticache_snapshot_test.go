@@ -0,0 +1,129 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSElasticacheSnapshot_basic(t *testing.T) {
+	var v elasticache.Snapshot
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+	resourceName := "aws_elasticache_snapshot.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSElasticacheSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSElasticacheSnapshotConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSElasticacheSnapshotExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "cluster_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_source", "manual"),
+					resource.TestCheckResourceAttr(resourceName, "engine", "redis"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "snapshot_create_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestMostRecentElasticacheSnapshot(t *testing.T) {
+	now := time.Now()
+	snapshot := func(name string, createTimes ...time.Time) *elasticache.Snapshot {
+		s := &elasticache.Snapshot{SnapshotName: aws.String(name)}
+		for _, createTime := range createTimes {
+			s.NodeSnapshots = append(s.NodeSnapshots, &elasticache.NodeSnapshot{SnapshotCreateTime: aws.Time(createTime)})
+		}
+		return s
+	}
+	snapshots := []*elasticache.Snapshot{
+		snapshot("old", now.Add(-2*time.Hour)),
+		snapshot("creating"),
+		snapshot("new", now, now.Add(time.Minute)),
+		snapshot("middle", now.Add(-time.Hour)),
+	}
+
+	if name := aws.StringValue(mostRecentElasticacheSnapshot(snapshots).SnapshotName); name != "new" {
+		t.Fatalf("expected most recent snapshot to be %q, got %q", "new", name)
+	}
+}
+
+func testAccCheckAWSElasticacheSnapshotExists(n string, v *elasticache.Snapshot) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+
+		snapshot, err := describeElasticacheSnapshot(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if snapshot == nil {
+			return fmt.Errorf("Error finding ElastiCache Snapshot %s", rs.Primary.ID)
+		}
+
+		*v = *snapshot
+		return nil
+	}
+}
+
+func testAccCheckAWSElasticacheSnapshotDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_elasticache_snapshot" {
+			continue
+		}
+
+		snapshot, err := describeElasticacheSnapshot(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if snapshot != nil {
+			return fmt.Errorf("ElastiCache Snapshot %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSElasticacheSnapshotConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_cluster" "test" {
+  cluster_id           = "%[1]s"
+  engine               = "redis"
+  node_type            = "cache.m3.medium"
+  num_cache_nodes      = 1
+  parameter_group_name = "default.redis3.2"
+}
+
+resource "aws_elasticache_snapshot" "test" {
+  snapshot_name = "%[1]s"
+  cluster_id    = "${aws_elasticache_cluster.test.id}"
+}
+`, rName)
+}
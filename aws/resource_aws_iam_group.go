@@ -21,6 +21,8 @@ func resourceAwsIamGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffIamPolicySets(iamGroupPolicyEntity),
+
 		Schema: map[string]*schema.Schema{
 			"arn": &schema.Schema{
 				Type:     schema.TypeString,
@@ -40,6 +42,8 @@ func resourceAwsIamGroup() *schema.Resource {
 				Optional: true,
 				Default:  "/",
 			},
+			"inline_policy":       iamInlinePolicySchema(),
+			"managed_policy_arns": iamManagedPolicyArnsSchema(),
 		},
 	}
 }
@@ -60,6 +64,10 @@ func resourceAwsIamGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(*createResp.Group.GroupName)
 
+	if err := updateIamPolicySets(d, iamconn, iamGroupPolicyEntity, d.Id()); err != nil {
+		return err
+	}
+
 	return resourceAwsIamGroupReadResult(d, createResp.Group)
 }
 
@@ -78,7 +86,12 @@ func resourceAwsIamGroupRead(d *schema.ResourceData, meta interface{}) error {
 		}
 		return fmt.Errorf("Error reading IAM Group %s: %s", d.Id(), err)
 	}
-	return resourceAwsIamGroupReadResult(d, getResp.Group)
+
+	if err := resourceAwsIamGroupReadResult(d, getResp.Group); err != nil {
+		return err
+	}
+
+	return readIamPolicySets(d, iamconn, iamGroupPolicyEntity, d.Id())
 }
 
 func resourceAwsIamGroupReadResult(d *schema.ResourceData, group *iam.Group) error {
@@ -98,8 +111,9 @@ func resourceAwsIamGroupReadResult(d *schema.ResourceData, group *iam.Group) err
 }
 
 func resourceAwsIamGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	iamconn := meta.(*AWSClient).iamconn
+
 	if d.HasChange("name") || d.HasChange("path") {
-		iamconn := meta.(*AWSClient).iamconn
 		on, nn := d.GetChange("name")
 		_, np := d.GetChange("path")
 
@@ -112,14 +126,22 @@ func resourceAwsIamGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			return fmt.Errorf("Error updating IAM Group %s: %s", d.Id(), err)
 		}
-		return resourceAwsIamGroupRead(d, meta)
 	}
-	return nil
+
+	if err := updateIamPolicySets(d, iamconn, iamGroupPolicyEntity, d.Get("name").(string)); err != nil {
+		return err
+	}
+
+	return resourceAwsIamGroupRead(d, meta)
 }
 
 func resourceAwsIamGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	iamconn := meta.(*AWSClient).iamconn
 
+	if err := deleteIamPolicySets(d, iamconn, iamGroupPolicyEntity, d.Id()); err != nil {
+		return err
+	}
+
 	request := &iam.DeleteGroupInput{
 		GroupName: aws.String(d.Id()),
 	}
@@ -22,10 +22,13 @@ func resourceAwsIamGroupPolicy() *schema.Resource {
 		Read:   resourceAwsIamGroupPolicyRead,
 		Delete: resourceAwsIamGroupPolicyDelete,
 
+		CustomizeDiff: customizeDiffIamStandalonePolicy("aws_iam_group_policy", "group", iamPolicyKindInline, "group"),
+
 		Schema: map[string]*schema.Schema{
 			"policy": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateIAMIdentityPolicyJson,
 			},
 			"name": &schema.Schema{
 				Type:          schema.TypeString,
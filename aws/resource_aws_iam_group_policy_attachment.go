@@ -17,6 +17,8 @@ func resourceAwsIamGroupPolicyAttachment() *schema.Resource {
 		Read:   resourceAwsIamGroupPolicyAttachmentRead,
 		Delete: resourceAwsIamGroupPolicyAttachmentDelete,
 
+		CustomizeDiff: customizeDiffIamStandalonePolicy("aws_iam_group_policy_attachment", "group", iamPolicyKindManaged, "group"),
+
 		Schema: map[string]*schema.Schema{
 			"group": &schema.Schema{
 				Type:     schema.TypeString,
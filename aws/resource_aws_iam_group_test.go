@@ -164,3 +164,106 @@ resource "aws_iam_group" "group2" {
 	path = "/funnypath/"
 }`, groupName)
 }
+
+func TestAccAWSIAMGroup_policySets(t *testing.T) {
+	var conf iam.GetGroupOutput
+	rName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGroupConfig_policySets(rName, "ec2:Describe*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGroupExists("aws_iam_group.test", &conf),
+					resource.TestCheckResourceAttr("aws_iam_group.test", "inline_policy.#", "1"),
+					resource.TestCheckResourceAttr("aws_iam_group.test", "managed_policy_arns.#", "1"),
+					testAccCheckAWSGroupPolicyCounts("aws_iam_group.test", 1, 1),
+				),
+			},
+			{
+				Config: testAccAWSGroupConfig_policySets(rName, "s3:List*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGroupExists("aws_iam_group.test", &conf),
+					resource.TestCheckResourceAttr("aws_iam_group.test", "inline_policy.#", "1"),
+					testAccCheckAWSGroupPolicyCounts("aws_iam_group.test", 1, 1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSGroupPolicyCounts(n string, inline, managed int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+
+		names, err := iamGroupPolicyEntity.listInline(iamconn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if len(names) != inline {
+			return fmt.Errorf("Expected %d inline policies on IAM Group (%s), got: %v", inline, rs.Primary.ID, names)
+		}
+
+		arns, err := iamGroupPolicyEntity.listManaged(iamconn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if len(arns) != managed {
+			return fmt.Errorf("Expected %d attached policies on IAM Group (%s), got: %v", managed, rs.Primary.ID, arns)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSGroupConfig_policySets(rName, action string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_policy" "test" {
+  name = "tf-acc-policy-%[1]s"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "ec2:Describe*",
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_group" "test" {
+  name = "tf-acc-group-%[1]s"
+
+  inline_policy {
+    name = "tf-acc-inline-%[1]s"
+
+    policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "%[2]s",
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+  }
+
+  managed_policy_arns = ["${aws_iam_policy.test.arn}"]
+}
+`, rName, action)
+}
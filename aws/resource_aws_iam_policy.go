@@ -4,6 +4,10 @@ import (
 	"fmt"
 	"net/url"
 	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -11,8 +15,12 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
+// iamPolicyMaxVersions is the number of versions IAM keeps for a managed policy.
+const iamPolicyMaxVersions = 5
+
 func resourceAwsIamPolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsIamPolicyCreate,
@@ -38,7 +46,7 @@ func resourceAwsIamPolicy() *schema.Resource {
 			"policy": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateFunc:     validateIAMPolicyJson,
+				ValidateFunc:     validateIAMIdentityPolicyJson,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
 			},
 			"name": {
@@ -83,6 +91,46 @@ func resourceAwsIamPolicy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"max_versions": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      iamPolicyMaxVersions,
+				ValidateFunc: validation.IntBetween(1, iamPolicyMaxVersions),
+			},
+			"prune_versions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"pinned_version_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(v[1-9][0-9]*)?$`), "must be a policy version ID such as \"v2\""),
+			},
+			"default_version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_default_version": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -110,8 +158,15 @@ func resourceAwsIamPolicyCreate(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return fmt.Errorf("Error creating IAM policy %s: %s", name, err)
 	}
+	d.SetId(*response.Policy.Arn)
+
+	if v, ok := d.GetOk("pinned_version_id"); ok && v.(string) != *response.Policy.DefaultVersionId {
+		if err := iamPolicySetDefaultVersion(d.Id(), v.(string), iamconn); err != nil {
+			return err
+		}
+	}
 
-	return readIamPolicy(d, response.Policy)
+	return resourceAwsIamPolicyRead(d, meta)
 }
 
 func resourceAwsIamPolicyRead(d *schema.ResourceData, meta interface{}) error {
@@ -130,9 +185,26 @@ func resourceAwsIamPolicyRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error reading IAM policy %s: %s", d.Id(), err)
 	}
 
+	versions, err := iamPolicyListVersions(d.Id(), iamconn)
+	if err != nil {
+		return err
+	}
+	iamPolicySortVersions(versions)
+	if err := d.Set("versions", flattenIamPolicyVersions(versions)); err != nil {
+		return fmt.Errorf("Error setting versions for IAM policy %s: %s", d.Id(), err)
+	}
+	d.Set("default_version_id", getPolicyResponse.Policy.DefaultVersionId)
+
+	// While the default version is pinned, the policy argument tracks the
+	// most recently created version rather than the one in effect.
+	versionID := getPolicyResponse.Policy.DefaultVersionId
+	if d.Get("pinned_version_id").(string) != "" && len(versions) > 0 {
+		versionID = versions[len(versions)-1].VersionId
+	}
+
 	getPolicyVersionRequest := &iam.GetPolicyVersionInput{
 		PolicyArn: aws.String(d.Id()),
-		VersionId: getPolicyResponse.Policy.DefaultVersionId,
+		VersionId: versionID,
 	}
 
 	getPolicyVersionResponse, err := iamconn.GetPolicyVersion(getPolicyVersionRequest)
@@ -158,23 +230,57 @@ func resourceAwsIamPolicyRead(d *schema.ResourceData, meta interface{}) error {
 func resourceAwsIamPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 	iamconn := meta.(*AWSClient).iamconn
 
-	if err := iamPolicyPruneVersions(d.Id(), iamconn); err != nil {
-		return err
-	}
+	pinned := d.Get("pinned_version_id").(string)
+	prune := d.Get("prune_versions").(bool)
 
-	if !d.HasChange("policy") {
-		return nil
+	if d.HasChange("policy") {
+		versions, err := iamPolicyListVersions(d.Id(), iamconn)
+		if err != nil {
+			return err
+		}
+		if len(versions) >= iamPolicyMaxVersions {
+			if !prune {
+				return fmt.Errorf("Error updating IAM policy %s: the policy already has %d versions and prune_versions is disabled, delete a version to make room for the update", d.Id(), len(versions))
+			}
+			if err := iamPolicyPruneVersions(d.Id(), iamPolicyMaxVersions-1, pinned, iamconn); err != nil {
+				return err
+			}
+		}
+
+		request := &iam.CreatePolicyVersionInput{
+			PolicyArn:      aws.String(d.Id()),
+			PolicyDocument: aws.String(d.Get("policy").(string)),
+			SetAsDefault:   aws.Bool(pinned == ""),
+		}
+
+		if _, err := iamconn.CreatePolicyVersion(request); err != nil {
+			return fmt.Errorf("Error updating IAM policy %s: %s", d.Id(), err)
+		}
 	}
-	request := &iam.CreatePolicyVersionInput{
-		PolicyArn:      aws.String(d.Id()),
-		PolicyDocument: aws.String(d.Get("policy").(string)),
-		SetAsDefault:   aws.Bool(true),
+
+	if d.HasChange("pinned_version_id") {
+		versionID := pinned
+		if versionID == "" {
+			// Unpinning restores the most recent version as the default.
+			versions, err := iamPolicyListVersions(d.Id(), iamconn)
+			if err != nil {
+				return err
+			}
+			iamPolicySortVersions(versions)
+			versionID = *versions[len(versions)-1].VersionId
+		}
+		if err := iamPolicySetDefaultVersion(d.Id(), versionID, iamconn); err != nil {
+			return err
+		}
 	}
 
-	if _, err := iamconn.CreatePolicyVersion(request); err != nil {
-		return fmt.Errorf("Error updating IAM policy %s: %s", d.Id(), err)
+	if prune {
+		if err := iamPolicyPruneVersions(d.Id(), d.Get("max_versions").(int), pinned, iamconn); err != nil {
+			return err
+		}
 	}
-	return nil
+
+	return resourceAwsIamPolicyRead(d, meta)
 }
 
 func resourceAwsIamPolicyDelete(d *schema.ResourceData, meta interface{}) error {
@@ -198,40 +304,94 @@ func resourceAwsIamPolicyDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-// iamPolicyPruneVersions deletes the oldest versions.
-//
-// Old versions are deleted until there are 4 or less remaining, which means at
-// least one more can be created before hitting the maximum of 5.
+// iamPolicyPruneVersions deletes the oldest versions until at most keep
+// versions remain.
 //
-// The default version is never deleted.
-
-func iamPolicyPruneVersions(arn string, iamconn *iam.IAM) error {
+// The default version, the pinned version and the most recent version are
+// never deleted, so fewer versions may be removed than requested.
+func iamPolicyPruneVersions(arn string, keep int, pinned string, iamconn *iam.IAM) error {
 	versions, err := iamPolicyListVersions(arn, iamconn)
 	if err != nil {
 		return err
 	}
-	if len(versions) < 5 {
+
+	for _, versionID := range iamPolicyVersionsToPrune(versions, keep, pinned) {
+		if err := iamPolicyDeleteVersion(arn, versionID, iamconn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// iamPolicyVersionsToPrune returns the IDs of the versions, oldest first, that
+// have to be deleted to bring the number of versions down to keep.
+func iamPolicyVersionsToPrune(versions []*iam.PolicyVersion, keep int, pinned string) []string {
+	if len(versions) <= keep {
 		return nil
 	}
 
-	var oldestVersion *iam.PolicyVersion
+	sorted := make([]*iam.PolicyVersion, len(versions))
+	copy(sorted, versions)
+	iamPolicySortVersions(sorted)
 
-	for _, version := range versions {
-		if *version.IsDefaultVersion {
+	var ids []string
+	remaining := len(sorted)
+	for _, version := range sorted[:len(sorted)-1] {
+		if remaining <= keep {
+			break
+		}
+		if aws.BoolValue(version.IsDefaultVersion) || aws.StringValue(version.VersionId) == pinned {
 			continue
 		}
-		if oldestVersion == nil ||
-			version.CreateDate.Before(*oldestVersion.CreateDate) {
-			oldestVersion = version
+		ids = append(ids, aws.StringValue(version.VersionId))
+		remaining--
+	}
+	return ids
+}
+
+// iamPolicySortVersions sorts versions from oldest to newest. Version IDs
+// break ties as create dates only have a resolution of one second.
+func iamPolicySortVersions(versions []*iam.PolicyVersion) {
+	sort.Slice(versions, func(i, j int) bool {
+		ti, tj := aws.TimeValue(versions[i].CreateDate), aws.TimeValue(versions[j].CreateDate)
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
 		}
+		return iamPolicyVersionNumber(versions[i].VersionId) < iamPolicyVersionNumber(versions[j].VersionId)
+	})
+}
+
+func iamPolicyVersionNumber(versionID *string) int {
+	n, _ := strconv.Atoi(strings.TrimPrefix(aws.StringValue(versionID), "v"))
+	return n
+}
+
+func iamPolicySetDefaultVersion(arn, versionID string, iamconn *iam.IAM) error {
+	request := &iam.SetDefaultPolicyVersionInput{
+		PolicyArn: aws.String(arn),
+		VersionId: aws.String(versionID),
 	}
 
-	if err := iamPolicyDeleteVersion(arn, *oldestVersion.VersionId, iamconn); err != nil {
-		return err
+	if _, err := iamconn.SetDefaultPolicyVersion(request); err != nil {
+		return fmt.Errorf("Error setting version %s as the default of IAM policy %s: %s", versionID, arn, err)
 	}
 	return nil
 }
 
+// flattenIamPolicyVersions returns the versions newest first.
+func flattenIamPolicyVersions(versions []*iam.PolicyVersion) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(versions))
+	for i := len(versions) - 1; i >= 0; i-- {
+		version := versions[i]
+		result = append(result, map[string]interface{}{
+			"version_id":         aws.StringValue(version.VersionId),
+			"create_date":        aws.TimeValue(version.CreateDate).Format(time.RFC3339),
+			"is_default_version": aws.BoolValue(version.IsDefaultVersion),
+		})
+	}
+	return result
+}
+
 func iamPolicyDeleteNondefaultVersions(arn string, iamconn *iam.IAM) error {
 	versions, err := iamPolicyListVersions(arn, iamconn)
 	if err != nil {
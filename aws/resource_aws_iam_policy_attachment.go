@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -21,6 +22,12 @@ func resourceAwsIamPolicyAttachment() *schema.Resource {
 		Update: resourceAwsIamPolicyAttachmentUpdate,
 		Delete: resourceAwsIamPolicyAttachmentDelete,
 
+		CustomizeDiff: customdiff.All(
+			customizeDiffIamStandalonePolicy("aws_iam_policy_attachment", "role", iamPolicyKindManaged, "roles"),
+			customizeDiffIamStandalonePolicy("aws_iam_policy_attachment", "user", iamPolicyKindManaged, "users"),
+			customizeDiffIamStandalonePolicy("aws_iam_policy_attachment", "group", iamPolicyKindManaged, "groups"),
+		),
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:         schema.TypeString,
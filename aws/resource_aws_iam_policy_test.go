@@ -5,9 +5,11 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -46,6 +48,134 @@ func TestAWSPolicy_invalidJson(t *testing.T) {
 	})
 }
 
+func TestAWSPolicy_versions(t *testing.T) {
+	var out iam.GetPolicyOutput
+	rName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPolicyVersionsConfig(rName, "ec2:Describe*", 2, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPolicyExists("aws_iam_policy.policy", &out),
+					resource.TestCheckResourceAttr("aws_iam_policy.policy", "default_version_id", "v1"),
+					resource.TestCheckResourceAttr("aws_iam_policy.policy", "versions.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSPolicyVersionsConfig(rName, "s3:List*", 2, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_iam_policy.policy", "default_version_id", "v2"),
+					resource.TestCheckResourceAttr("aws_iam_policy.policy", "versions.#", "2"),
+					resource.TestCheckResourceAttr("aws_iam_policy.policy", "versions.0.version_id", "v2"),
+					resource.TestCheckResourceAttr("aws_iam_policy.policy", "versions.0.is_default_version", "true"),
+					resource.TestCheckResourceAttr("aws_iam_policy.policy", "versions.1.version_id", "v1"),
+				),
+			},
+			{
+				Config: testAccAWSPolicyVersionsConfig(rName, "sqs:List*", 2, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_iam_policy.policy", "default_version_id", "v3"),
+					resource.TestCheckResourceAttr("aws_iam_policy.policy", "versions.#", "2"),
+					resource.TestCheckResourceAttr("aws_iam_policy.policy", "versions.1.version_id", "v2"),
+				),
+			},
+			{
+				Config: testAccAWSPolicyVersionsConfig(rName, "sqs:List*", 2, "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_iam_policy.policy", "default_version_id", "v2"),
+					resource.TestCheckResourceAttr("aws_iam_policy.policy", "versions.0.version_id", "v3"),
+					resource.TestCheckResourceAttr("aws_iam_policy.policy", "versions.0.is_default_version", "false"),
+				),
+			},
+			{
+				Config: testAccAWSPolicyVersionsConfig(rName, "sqs:List*", 2, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_iam_policy.policy", "default_version_id", "v3"),
+				),
+			},
+		},
+	})
+}
+
+func TestIamPolicyVersionsToPrune(t *testing.T) {
+	now := time.Now()
+	version := func(id string, age time.Duration, isDefault bool) *iam.PolicyVersion {
+		return &iam.PolicyVersion{
+			VersionId:        aws.String(id),
+			CreateDate:       aws.Time(now.Add(-age)),
+			IsDefaultVersion: aws.Bool(isDefault),
+		}
+	}
+
+	cases := []struct {
+		Name     string
+		Versions []*iam.PolicyVersion
+		Keep     int
+		Pinned   string
+		Expected []string
+	}{
+		{
+			Name:     "under the limit",
+			Versions: []*iam.PolicyVersion{version("v1", 2*time.Hour, false), version("v2", time.Hour, true)},
+			Keep:     5,
+		},
+		{
+			Name: "oldest first",
+			Versions: []*iam.PolicyVersion{
+				version("v5", 0, true),
+				version("v3", 2*time.Hour, false),
+				version("v1", 4*time.Hour, false),
+				version("v4", time.Hour, false),
+				version("v2", 3*time.Hour, false),
+			},
+			Keep:     2,
+			Expected: []string{"v1", "v2", "v3"},
+		},
+		{
+			Name: "default and pinned versions are kept",
+			Versions: []*iam.PolicyVersion{
+				version("v1", 4*time.Hour, true),
+				version("v2", 3*time.Hour, false),
+				version("v3", 2*time.Hour, false),
+				version("v4", time.Hour, false),
+				version("v5", 0, false),
+			},
+			Keep:     3,
+			Pinned:   "v2",
+			Expected: []string{"v3", "v4"},
+		},
+		{
+			Name: "latest version is kept",
+			Versions: []*iam.PolicyVersion{
+				version("v1", time.Hour, true),
+				version("v2", 0, false),
+			},
+			Keep: 1,
+		},
+		{
+			Name: "same create date",
+			Versions: []*iam.PolicyVersion{
+				version("v10", 0, true),
+				version("v9", 0, false),
+				version("v11", 0, false),
+			},
+			Keep:     2,
+			Expected: []string{"v9"},
+		},
+	}
+
+	for _, tc := range cases {
+		actual := iamPolicyVersionsToPrune(tc.Versions, tc.Keep, tc.Pinned)
+		if strings.Join(actual, ",") != strings.Join(tc.Expected, ",") {
+			t.Errorf("%s: expected %v, got %v", tc.Name, tc.Expected, actual)
+		}
+	}
+}
+
 func testAccCheckAWSPolicyExists(resource string, res *iam.GetPolicyOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resource]
@@ -129,3 +259,26 @@ resource "aws_iam_policy" "policy" {
   EOF
 }
 `
+
+func testAccAWSPolicyVersionsConfig(rName, action string, maxVersions int, pinned string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_policy" "policy" {
+  name              = "test-policy-%s"
+  max_versions      = %d
+  pinned_version_id = "%s"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "%s",
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+`, rName, maxVersions, pinned, action)
+}
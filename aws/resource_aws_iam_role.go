@@ -26,6 +26,8 @@ func resourceAwsIamRole() *schema.Resource {
 			State: resourceAwsIamRoleImport,
 		},
 
+		CustomizeDiff: customizeDiffIamPolicySets(iamRolePolicyEntity),
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -94,7 +96,7 @@ func resourceAwsIamRole() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
-				ValidateFunc:     validateJsonString,
+				ValidateFunc:     validateIAMTrustPolicyJson,
 			},
 
 			"force_detach_policies": {
@@ -114,6 +116,10 @@ func resourceAwsIamRole() *schema.Resource {
 				Default:      3600,
 				ValidateFunc: validation.IntBetween(3600, 43200),
 			},
+
+			"inline_policy": iamInlinePolicySchema(),
+
+			"managed_policy_arns": iamManagedPolicyArnsSchema(),
 		},
 	}
 }
@@ -150,21 +156,20 @@ func resourceAwsIamRoleCreate(d *schema.ResourceData, meta interface{}) error {
 		request.MaxSessionDuration = aws.Int64(int64(v.(int)))
 	}
 
-	var createResp *iam.CreateRoleOutput
-	err := resource.Retry(30*time.Second, func() *resource.RetryError {
-		var err error
-		createResp, err = iamconn.CreateRole(request)
-		// IAM users (referenced in Principal field of assume policy)
-		// can take ~30 seconds to propagate in AWS
-		if isAWSErr(err, "MalformedPolicyDocument", "Invalid principal in policy") {
-			return resource.RetryableError(err)
-		}
-		return resource.NonRetryableError(err)
+	// IAM users (referenced in Principal field of assume policy)
+	// can take ~30 seconds to propagate in AWS
+	createResp, err := retryOnIamPropagation(meta, "iam", func() (interface{}, error) {
+		return iamconn.CreateRole(request)
 	})
 	if err != nil {
 		return fmt.Errorf("Error creating IAM Role %s: %s", name, err)
 	}
-	d.SetId(*createResp.Role.RoleName)
+	d.SetId(*createResp.(*iam.CreateRoleOutput).Role.RoleName)
+
+	if err := updateIamPolicySets(d, iamconn, iamRolePolicyEntity, d.Id()); err != nil {
+		return err
+	}
+
 	return resourceAwsIamRoleRead(d, meta)
 }
 
@@ -219,7 +224,8 @@ func resourceAwsIamRoleRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("assume_role_policy", assumRolePolicy); err != nil {
 		return err
 	}
-	return nil
+
+	return readIamPolicySets(d, iamconn, iamRolePolicyEntity, d.Id())
 }
 
 func resourceAwsIamRoleUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -270,7 +276,7 @@ func resourceAwsIamRoleUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return nil
+	return updateIamPolicySets(d, iamconn, iamRolePolicyEntity, d.Id())
 }
 
 func resourceAwsIamRoleDelete(d *schema.ResourceData, meta interface{}) error {
@@ -355,6 +361,8 @@ func resourceAwsIamRoleDelete(d *schema.ResourceData, meta interface{}) error {
 				}
 			}
 		}
+	} else if err := deleteIamPolicySets(d, iamconn, iamRolePolicyEntity, d.Id()); err != nil {
+		return err
 	}
 
 	request := &iam.DeleteRoleInput{
@@ -21,6 +21,9 @@ func resourceAwsIamRolePolicy() *schema.Resource {
 
 		Read:   resourceAwsIamRolePolicyRead,
 		Delete: resourceAwsIamRolePolicyDelete,
+
+		CustomizeDiff: customizeDiffIamStandalonePolicy("aws_iam_role_policy", "role", iamPolicyKindInline, "role"),
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -29,7 +32,7 @@ func resourceAwsIamRolePolicy() *schema.Resource {
 			"policy": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateFunc:     validateIAMPolicyJson,
+				ValidateFunc:     validateIAMIdentityPolicyJson,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
 			},
 			"name": {
@@ -17,6 +17,8 @@ func resourceAwsIamRolePolicyAttachment() *schema.Resource {
 		Read:   resourceAwsIamRolePolicyAttachmentRead,
 		Delete: resourceAwsIamRolePolicyAttachmentDelete,
 
+		CustomizeDiff: customizeDiffIamStandalonePolicy("aws_iam_role_policy_attachment", "role", iamPolicyKindManaged, "role"),
+
 		Schema: map[string]*schema.Schema{
 			"role": &schema.Schema{
 				Type:     schema.TypeString,
@@ -199,6 +199,92 @@ func TestAccAWSIAMRole_MaxSessionDuration(t *testing.T) {
 	})
 }
 
+func TestAccAWSIAMRole_inlinePolicy(t *testing.T) {
+	var conf iam.GetRoleOutput
+	rName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMRoleConfig_inlinePolicy(rName, "ec2:Describe*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoleExists("aws_iam_role.test", &conf),
+					resource.TestCheckResourceAttr("aws_iam_role.test", "inline_policy.#", "1"),
+					testAccCheckAWSRolePolicyCounts("aws_iam_role.test", 1, 0),
+					testAccAddAwsIAMRolePolicy("aws_iam_role.test"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAWSIAMRoleConfig_inlinePolicy(rName, "s3:List*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoleExists("aws_iam_role.test", &conf),
+					resource.TestCheckResourceAttr("aws_iam_role.test", "inline_policy.#", "1"),
+					testAccCheckAWSRolePolicyCounts("aws_iam_role.test", 1, 0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIAMRole_managedPolicyArns(t *testing.T) {
+	var conf iam.GetRoleOutput
+	rName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMRoleConfig_managedPolicyArns(rName, "aws_iam_policy.test1.arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoleExists("aws_iam_role.test", &conf),
+					resource.TestCheckResourceAttr("aws_iam_role.test", "managed_policy_arns.#", "1"),
+					testAccCheckAWSRolePolicyCounts("aws_iam_role.test", 0, 1),
+					testAccAttachAwsIAMRolePolicy("aws_iam_role.test", "aws_iam_policy.test2"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAWSIAMRoleConfig_managedPolicyArns(rName, "aws_iam_policy.test1.arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoleExists("aws_iam_role.test", &conf),
+					resource.TestCheckResourceAttr("aws_iam_role.test", "managed_policy_arns.#", "1"),
+					testAccCheckAWSRolePolicyCounts("aws_iam_role.test", 0, 1),
+				),
+			},
+			{
+				Config: testAccAWSIAMRoleConfig_managedPolicyArns(rName, "aws_iam_policy.test2.arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoleExists("aws_iam_role.test", &conf),
+					resource.TestCheckResourceAttr("aws_iam_role.test", "managed_policy_arns.#", "1"),
+					testAccCheckAWSRolePolicyCounts("aws_iam_role.test", 0, 1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIAMRole_managedPolicyArnsConflict(t *testing.T) {
+	rName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSIAMRoleConfig_managedPolicyArnsConflict(rName),
+				ExpectError: regexp.MustCompile(`managed_policy_arns conflicts with aws_iam_role_policy_attachment`),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSRoleDestroy(s *terraform.State) error {
 	iamconn := testAccProvider.Meta().(*AWSClient).iamconn
 
@@ -302,6 +388,51 @@ func testAccAddAwsIAMRolePolicy(n string) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckAWSRolePolicyCounts(n string, inline, managed int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+
+		names, err := iamRolePolicyEntity.listInline(iamconn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if len(names) != inline {
+			return fmt.Errorf("Expected %d inline policies on IAM Role (%s), got: %v", inline, rs.Primary.ID, names)
+		}
+
+		arns, err := iamRolePolicyEntity.listManaged(iamconn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if len(arns) != managed {
+			return fmt.Errorf("Expected %d attached policies on IAM Role (%s), got: %v", managed, rs.Primary.ID, arns)
+		}
+
+		return nil
+	}
+}
+
+func testAccAttachAwsIAMRolePolicy(n, policy string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		ps, ok := s.RootModule().Resources[policy]
+		if !ok {
+			return fmt.Errorf("Not found: %s", policy)
+		}
+
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		return attachPolicyToRole(iamconn, rs.Primary.ID, ps.Primary.Attributes["arn"])
+	}
+}
+
 func testAccCheckIAMRoleConfig_MaxSessionDuration(rName string, maxSessionDuration int) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "test" {
@@ -546,3 +677,92 @@ EOF
 }
 `, rName, rName, rName)
 }
+
+func testAccAWSIAMRoleConfig_inlinePolicy(rName, action string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name               = "tf-acc-role-%[1]s"
+  assume_role_policy = "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"Service\":[\"ec2.amazonaws.com\"]},\"Action\":[\"sts:AssumeRole\"]}]}"
+
+  inline_policy {
+    name = "tf-acc-inline-%[1]s"
+
+    policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "%[2]s",
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+  }
+}
+`, rName, action)
+}
+
+const testAccAWSIAMRoleConfig_managedPolicies = `
+resource "aws_iam_policy" "test1" {
+  name = "tf-acc-policy-1-%[1]s"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "ec2:Describe*",
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_policy" "test2" {
+  name = "tf-acc-policy-2-%[1]s"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "s3:List*",
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+`
+
+func testAccAWSIAMRoleConfig_managedPolicyArns(rName, policyArn string) string {
+	return fmt.Sprintf(testAccAWSIAMRoleConfig_managedPolicies+`
+resource "aws_iam_role" "test" {
+  name               = "tf-acc-role-%[1]s"
+  assume_role_policy = "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"Service\":[\"ec2.amazonaws.com\"]},\"Action\":[\"sts:AssumeRole\"]}]}"
+
+  managed_policy_arns = ["${%[2]s}"]
+}
+`, rName, policyArn)
+}
+
+func testAccAWSIAMRoleConfig_managedPolicyArnsConflict(rName string) string {
+	return fmt.Sprintf(testAccAWSIAMRoleConfig_managedPolicies+`
+resource "aws_iam_role" "test" {
+  name               = "tf-acc-role-%[1]s"
+  assume_role_policy = "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"Service\":[\"ec2.amazonaws.com\"]},\"Action\":[\"sts:AssumeRole\"]}]}"
+
+  managed_policy_arns = ["${aws_iam_policy.test1.arn}"]
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = "tf-acc-role-%[1]s"
+  policy_arn = "${aws_iam_policy.test2.arn}"
+}
+`, rName)
+}
@@ -24,6 +24,8 @@ func resourceAwsIamUser() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffIamPolicySets(iamUserPolicyEntity),
+
 		Schema: map[string]*schema.Schema{
 			"arn": &schema.Schema{
 				Type:     schema.TypeString,
@@ -57,6 +59,8 @@ func resourceAwsIamUser() *schema.Resource {
 				Default:     false,
 				Description: "Delete user even if it has non-Terraform-managed IAM access keys, login profile or MFA devices",
 			},
+			"inline_policy":       iamInlinePolicySchema(),
+			"managed_policy_arns": iamManagedPolicyArnsSchema(),
 		},
 	}
 }
@@ -77,6 +81,11 @@ func resourceAwsIamUserCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating IAM User %s: %s", name, err)
 	}
 	d.SetId(*createResp.User.UserName)
+
+	if err := updateIamPolicySets(d, iamconn, iamUserPolicyEntity, d.Id()); err != nil {
+		return err
+	}
+
 	return resourceAwsIamUserReadResult(d, createResp.User)
 }
 
@@ -96,7 +105,12 @@ func resourceAwsIamUserRead(d *schema.ResourceData, meta interface{}) error {
 		}
 		return fmt.Errorf("Error reading IAM User %s: %s", d.Id(), err)
 	}
-	return resourceAwsIamUserReadResult(d, getResp.User)
+
+	if err := resourceAwsIamUserReadResult(d, getResp.User); err != nil {
+		return err
+	}
+
+	return readIamPolicySets(d, iamconn, iamUserPolicyEntity, d.Id())
 }
 
 func resourceAwsIamUserReadResult(d *schema.ResourceData, user *iam.User) error {
@@ -116,8 +130,9 @@ func resourceAwsIamUserReadResult(d *schema.ResourceData, user *iam.User) error
 }
 
 func resourceAwsIamUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	iamconn := meta.(*AWSClient).iamconn
+
 	if d.HasChange("name") || d.HasChange("path") {
-		iamconn := meta.(*AWSClient).iamconn
 		on, nn := d.GetChange("name")
 		_, np := d.GetChange("path")
 
@@ -139,9 +154,13 @@ func resourceAwsIamUserUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		d.SetId(nn.(string))
-		return resourceAwsIamUserRead(d, meta)
 	}
-	return nil
+
+	if err := updateIamPolicySets(d, iamconn, iamUserPolicyEntity, d.Id()); err != nil {
+		return err
+	}
+
+	return resourceAwsIamUserRead(d, meta)
 }
 
 func resourceAwsIamUserDelete(d *schema.ResourceData, meta interface{}) error {
@@ -242,6 +261,10 @@ func resourceAwsIamUserDelete(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if err := deleteIamPolicySets(d, iamconn, iamUserPolicyEntity, d.Id()); err != nil {
+		return err
+	}
+
 	request := &iam.DeleteUserInput{
 		UserName: aws.String(d.Id()),
 	}
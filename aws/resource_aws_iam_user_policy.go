@@ -21,6 +21,8 @@ func resourceAwsIamUserPolicy() *schema.Resource {
 		Update: resourceAwsIamUserPolicyPut,
 		Delete: resourceAwsIamUserPolicyDelete,
 
+		CustomizeDiff: customizeDiffIamStandalonePolicy("aws_iam_user_policy", "user", iamPolicyKindInline, "user"),
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -29,7 +31,7 @@ func resourceAwsIamUserPolicy() *schema.Resource {
 			"policy": &schema.Schema{
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateFunc:     validateIAMPolicyJson,
+				ValidateFunc:     validateIAMIdentityPolicyJson,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
 			},
 			"name": &schema.Schema{
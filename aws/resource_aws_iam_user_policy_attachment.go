@@ -17,6 +17,8 @@ func resourceAwsIamUserPolicyAttachment() *schema.Resource {
 		Read:   resourceAwsIamUserPolicyAttachmentRead,
 		Delete: resourceAwsIamUserPolicyAttachmentDelete,
 
+		CustomizeDiff: customizeDiffIamStandalonePolicy("aws_iam_user_policy_attachment", "user", iamPolicyKindManaged, "user"),
+
 		Schema: map[string]*schema.Schema{
 			"user": &schema.Schema{
 				Type:     schema.TypeString,
@@ -212,3 +212,106 @@ resource "aws_iam_user" "user" {
 	path = "%s"
 }`, r, p)
 }
+
+func TestAccAWSUser_policySets(t *testing.T) {
+	var conf iam.GetUserOutput
+	rName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSUserConfig_policySets(rName, "ec2:Describe*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSUserExists("aws_iam_user.test", &conf),
+					resource.TestCheckResourceAttr("aws_iam_user.test", "inline_policy.#", "1"),
+					resource.TestCheckResourceAttr("aws_iam_user.test", "managed_policy_arns.#", "1"),
+					testAccCheckAWSUserPolicyCounts("aws_iam_user.test", 1, 1),
+				),
+			},
+			{
+				Config: testAccAWSUserConfig_policySets(rName, "s3:List*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSUserExists("aws_iam_user.test", &conf),
+					resource.TestCheckResourceAttr("aws_iam_user.test", "inline_policy.#", "1"),
+					testAccCheckAWSUserPolicyCounts("aws_iam_user.test", 1, 1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSUserPolicyCounts(n string, inline, managed int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+
+		names, err := iamUserPolicyEntity.listInline(iamconn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if len(names) != inline {
+			return fmt.Errorf("Expected %d inline policies on IAM User (%s), got: %v", inline, rs.Primary.ID, names)
+		}
+
+		arns, err := iamUserPolicyEntity.listManaged(iamconn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if len(arns) != managed {
+			return fmt.Errorf("Expected %d attached policies on IAM User (%s), got: %v", managed, rs.Primary.ID, arns)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSUserConfig_policySets(rName, action string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_policy" "test" {
+  name = "tf-acc-policy-%[1]s"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "ec2:Describe*",
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_user" "test" {
+  name = "tf-acc-user-%[1]s"
+
+  inline_policy {
+    name = "tf-acc-inline-%[1]s"
+
+    policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "%[2]s",
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+  }
+
+  managed_policy_arns = ["${aws_iam_policy.test.arn}"]
+}
+`, rName, action)
+}
@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/lambda"
 
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -100,27 +99,19 @@ func resourceAwsLambdaEventSourceMappingCreate(d *schema.ResourceData, meta inte
 	// function defined for the task cannot be assumed by Lambda.
 	//
 	// The role may exist, but the permissions may not have propagated, so we
-	// retry
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-		eventSourceMappingConfiguration, err := conn.CreateEventSourceMapping(params)
-		if err != nil {
-			if awserr, ok := err.(awserr.Error); ok {
-				if awserr.Code() == "InvalidParameterValueException" {
-					return resource.RetryableError(awserr)
-				}
-			}
-			return resource.NonRetryableError(err)
-		}
-		// No error
-		d.Set("uuid", eventSourceMappingConfiguration.UUID)
-		d.SetId(*eventSourceMappingConfiguration.UUID)
-		return nil
+	// retry for longer than the other Lambda calls
+	out, err := retryOnIamPropagationWithMinimum(meta, "lambda", 5*time.Minute, func() (interface{}, error) {
+		return conn.CreateEventSourceMapping(params)
 	})
 
 	if err != nil {
 		return fmt.Errorf("Error creating Lambda event source mapping: %s", err)
 	}
 
+	eventSourceMappingConfiguration := out.(*lambda.EventSourceMappingConfiguration)
+	d.Set("uuid", eventSourceMappingConfiguration.UUID)
+	d.SetId(*eventSourceMappingConfiguration.UUID)
+
 	return resourceAwsLambdaEventSourceMappingRead(d, meta)
 }
 
@@ -192,17 +183,8 @@ func resourceAwsLambdaEventSourceMappingUpdate(d *schema.ResourceData, meta inte
 		Enabled:      aws.Bool(d.Get("enabled").(bool)),
 	}
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-		_, err := conn.UpdateEventSourceMapping(params)
-		if err != nil {
-			if awserr, ok := err.(awserr.Error); ok {
-				if awserr.Code() == "InvalidParameterValueException" {
-					return resource.RetryableError(awserr)
-				}
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
+	_, err := retryOnIamPropagationWithMinimum(meta, "lambda", 5*time.Minute, func() (interface{}, error) {
+		return conn.UpdateEventSourceMapping(params)
 	})
 
 	if err != nil {
@@ -466,18 +466,8 @@ func resourceAwsLaunchConfigurationCreate(d *schema.ResourceData, meta interface
 
 	// IAM profiles can take ~10 seconds to propagate in AWS:
 	// http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/iam-roles-for-amazon-ec2.html#launch-instance-with-role-console
-	err = resource.Retry(90*time.Second, func() *resource.RetryError {
-		_, err := autoscalingconn.CreateLaunchConfiguration(&createLaunchConfigurationOpts)
-		if err != nil {
-			if isAWSErr(err, "ValidationError", "Invalid IamInstanceProfile") {
-				return resource.RetryableError(err)
-			}
-			if isAWSErr(err, "ValidationError", "You are not authorized to perform this operation") {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
+	_, err = retryOnIamPropagation(meta, "autoscaling", func() (interface{}, error) {
+		return autoscalingconn.CreateLaunchConfiguration(&createLaunchConfigurationOpts)
 	})
 	if err != nil {
 		return fmt.Errorf("Error creating launch configuration: %s", err)
@@ -214,6 +214,47 @@ func resourceAwsRDSCluster() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"restore_to_point_in_time": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				ConflictsWith: []string{
+					"s3_import",
+					"snapshot_identifier",
+					"replication_source_identifier",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_cluster_identifier": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"restore_to_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateRFC3339TimeString,
+						},
+						"use_latest_restorable_time": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"restore_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"full-copy",
+								"copy-on-write",
+							}, false),
+						},
+					},
+				},
+			},
+
 			"port": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -386,10 +427,10 @@ func resourceAwsRDSClusterCreate(d *schema.ResourceData, meta interface{}) error
 		}
 
 		log.Printf("[DEBUG] RDS Cluster restore from snapshot configuration: %s", opts)
-		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		err := resource.Retry(meta.(*AWSClient).iamPropagationTimeout(), func() *resource.RetryError {
 			_, err := conn.RestoreDBClusterFromSnapshot(&opts)
 			if err != nil {
-				if isAWSErr(err, "InvalidParameterValue", "IAM role ARN value is invalid or does not include the required permissions") {
+				if isIamPropagationErr("rds", err) {
 					return resource.RetryableError(err)
 				}
 				return resource.NonRetryableError(err)
@@ -425,6 +466,103 @@ func resourceAwsRDSClusterCreate(d *schema.ResourceData, meta interface{}) error
 				return err
 			}
 
+			err = resourceAwsRDSClusterUpdate(d, meta)
+			if err != nil {
+				return err
+			}
+		}
+	} else if v, ok := d.GetOk("restore_to_point_in_time"); ok {
+		pointInTime := v.([]interface{})[0].(map[string]interface{})
+		opts := rds.RestoreDBClusterToPointInTimeInput{
+			DBClusterIdentifier:       aws.String(d.Get("cluster_identifier").(string)),
+			SourceDBClusterIdentifier: aws.String(pointInTime["source_cluster_identifier"].(string)),
+			Tags:                      tags,
+		}
+
+		restoreTime, useLatest, err := expandRdsRestoreTime(pointInTime["restore_to_time"].(string), pointInTime["use_latest_restorable_time"].(bool))
+		if err != nil {
+			return fmt.Errorf("Error creating RDS Cluster: %s", err)
+		}
+		opts.RestoreToTime = restoreTime
+		opts.UseLatestRestorableTime = useLatest
+
+		if v, ok := pointInTime["restore_type"].(string); ok && v != "" {
+			opts.RestoreType = aws.String(v)
+		}
+
+		// Need to check value > 0 due to:
+		// InvalidParameterValue: Backtrack is not enabled for the aurora-postgresql engine.
+		if v, ok := d.GetOk("backtrack_window"); ok && v.(int) > 0 {
+			opts.BacktrackWindow = aws.Int64(int64(v.(int)))
+		}
+
+		if attr, ok := d.GetOk("db_subnet_group_name"); ok {
+			opts.DBSubnetGroupName = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("iam_database_authentication_enabled"); ok {
+			opts.EnableIAMDatabaseAuthentication = aws.Bool(attr.(bool))
+		}
+
+		if attr, ok := d.GetOk("kms_key_id"); ok {
+			opts.KmsKeyId = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("port"); ok {
+			opts.Port = aws.Int64(int64(attr.(int)))
+		}
+
+		if attr := d.Get("vpc_security_group_ids").(*schema.Set); attr.Len() > 0 {
+			opts.VpcSecurityGroupIds = expandStringList(attr.List())
+		}
+
+		// Check if any of the parameters that require a cluster modification after creation are set
+		var clusterUpdate bool
+		for _, k := range []string{"db_cluster_parameter_group_name", "backup_retention_period", "master_password", "preferred_backup_window", "preferred_maintenance_window"} {
+			if _, ok := d.GetOk(k); ok {
+				clusterUpdate = true
+			}
+		}
+
+		log.Printf("[DEBUG] RDS Cluster restore to point in time configuration: %s", opts)
+		err = resource.Retry(meta.(*AWSClient).iamPropagationTimeout(), func() *resource.RetryError {
+			_, err := conn.RestoreDBClusterToPointInTime(&opts)
+			if err != nil {
+				if isIamPropagationErr("rds", err) {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Error creating RDS Cluster: %s", err)
+		}
+
+		if clusterUpdate {
+			log.Printf("[INFO] RDS Cluster is restoring to a point in time with the settings of the source cluster, will now update after the restore!")
+
+			d.SetId(d.Get("cluster_identifier").(string))
+
+			log.Printf("[INFO] RDS Cluster ID: %s", d.Id())
+
+			log.Println("[INFO] Waiting for RDS Cluster to be available")
+
+			stateConf := &resource.StateChangeConf{
+				Pending:    resourceAwsRdsClusterCreatePendingStates,
+				Target:     []string{"available"},
+				Refresh:    resourceAwsRDSClusterStateRefreshFunc(d, meta),
+				Timeout:    d.Timeout(schema.TimeoutCreate),
+				MinTimeout: 10 * time.Second,
+				Delay:      30 * time.Second,
+			}
+
+			// Wait, catching any errors
+			_, err := stateConf.WaitForState()
+			if err != nil {
+				return err
+			}
+
 			err = resourceAwsRDSClusterUpdate(d, meta)
 			if err != nil {
 				return err
@@ -491,11 +629,11 @@ func resourceAwsRDSClusterCreate(d *schema.ResourceData, meta interface{}) error
 
 		log.Printf("[DEBUG] Create RDS Cluster as read replica: %s", createOpts)
 		var resp *rds.CreateDBClusterOutput
-		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		err := resource.Retry(meta.(*AWSClient).iamPropagationTimeout(), func() *resource.RetryError {
 			var err error
 			resp, err = conn.CreateDBCluster(createOpts)
 			if err != nil {
-				if isAWSErr(err, "InvalidParameterValue", "IAM role ARN value is invalid or does not include the required permissions") {
+				if isIamPropagationErr("rds", err) {
 					return resource.RetryableError(err)
 				}
 				return resource.NonRetryableError(err)
@@ -685,11 +823,11 @@ func resourceAwsRDSClusterCreate(d *schema.ResourceData, meta interface{}) error
 
 		log.Printf("[DEBUG] RDS Cluster create options: %s", createOpts)
 		var resp *rds.CreateDBClusterOutput
-		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		err := resource.Retry(meta.(*AWSClient).iamPropagationTimeout(), func() *resource.RetryError {
 			var err error
 			resp, err = conn.CreateDBCluster(createOpts)
 			if err != nil {
-				if isAWSErr(err, "InvalidParameterValue", "IAM role ARN value is invalid or does not include the required permissions") {
+				if isIamPropagationErr("rds", err) {
 					return resource.RetryableError(err)
 				}
 				return resource.NonRetryableError(err)
@@ -905,7 +1043,7 @@ func resourceAwsRDSClusterUpdate(d *schema.ResourceData, meta interface{}) error
 		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
 			_, err := conn.ModifyDBCluster(req)
 			if err != nil {
-				if isAWSErr(err, "InvalidParameterValue", "IAM role ARN value is invalid or does not include the required permissions") {
+				if isIamPropagationErr("rds", err) {
 					return resource.RetryableError(err)
 				}
 				if isAWSErr(err, rds.ErrCodeInvalidDBClusterStateFault, "") {
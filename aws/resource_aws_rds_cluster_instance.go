@@ -268,21 +268,13 @@ func resourceAwsRDSClusterInstanceCreate(d *schema.ResourceData, meta interface{
 	}
 
 	log.Printf("[DEBUG] Creating RDS DB Instance opts: %s", createOpts)
-	var resp *rds.CreateDBInstanceOutput
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		var err error
-		resp, err = conn.CreateDBInstance(createOpts)
-		if err != nil {
-			if isAWSErr(err, "InvalidParameterValue", "IAM role ARN value is invalid or does not include the required permissions") {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
+	out, err := retryOnIamPropagation(meta, "rds", func() (interface{}, error) {
+		return conn.CreateDBInstance(createOpts)
 	})
 	if err != nil {
 		return fmt.Errorf("error creating RDS DB Instance: %s", err)
 	}
+	resp := out.(*rds.CreateDBInstanceOutput)
 
 	d.SetId(*resp.DBInstance.DBInstanceIdentifier)
 
@@ -431,15 +423,14 @@ func resourceAwsRDSClusterInstanceUpdate(d *schema.ResourceData, meta interface{
 		requestUpdate = true
 	}
 
-	if d.HasChange("performance_insights_enabled") {
+	if d.HasChange("performance_insights_enabled") || d.HasChange("performance_insights_kms_key_id") {
 		d.SetPartial("performance_insights_enabled")
-		req.EnablePerformanceInsights = aws.Bool(d.Get("performance_insights_enabled").(bool))
-		requestUpdate = true
-	}
-
-	if d.HasChange("performance_insights_kms_key_id") {
 		d.SetPartial("performance_insights_kms_key_id")
-		req.PerformanceInsightsKMSKeyId = aws.String(d.Get("performance_insights_kms_key_id").(string))
+		req.EnablePerformanceInsights = aws.Bool(d.Get("performance_insights_enabled").(bool))
+		// The KMS key can only be specified when enabling Performance Insights.
+		if v, ok := d.GetOk("performance_insights_kms_key_id"); ok && *req.EnablePerformanceInsights {
+			req.PerformanceInsightsKMSKeyId = aws.String(v.(string))
+		}
 		requestUpdate = true
 	}
 
@@ -476,15 +467,8 @@ func resourceAwsRDSClusterInstanceUpdate(d *schema.ResourceData, meta interface{
 	log.Printf("[DEBUG] Send DB Instance Modification request: %#v", requestUpdate)
 	if requestUpdate {
 		log.Printf("[DEBUG] DB Instance Modification request: %#v", req)
-		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-			_, err := conn.ModifyDBInstance(req)
-			if err != nil {
-				if isAWSErr(err, "InvalidParameterValue", "IAM role ARN value is invalid or does not include the required permissions") {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
+		_, err := retryOnIamPropagation(meta, "rds", func() (interface{}, error) {
+			return conn.ModifyDBInstance(req)
 		})
 		if err != nil {
 			return fmt.Errorf("Error modifying DB Instance %s: %s", d.Id(), err)
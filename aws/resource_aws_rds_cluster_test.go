@@ -83,6 +83,30 @@ func TestAccAWSRDSCluster_BacktrackWindow(t *testing.T) {
 	})
 }
 
+func TestAccAWSRDSCluster_restoreToPointInTime(t *testing.T) {
+	var sourceCluster, dbCluster rds.DBCluster
+	rInt := acctest.RandInt()
+	resourceName := "aws_rds_cluster.restore"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSClusterConfig_restoreToPointInTime(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSClusterExists("aws_rds_cluster.default", &sourceCluster),
+					testAccCheckAWSClusterExists(resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "restore_to_point_in_time.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "restore_to_point_in_time.0.restore_type", "copy-on-write"),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_period", "3"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSRDSCluster_namePrefix(t *testing.T) {
 	var v rds.DBCluster
 
@@ -574,6 +598,29 @@ resource "aws_rds_cluster" "default" {
 }`, n)
 }
 
+func testAccAWSClusterConfig_restoreToPointInTime(n int) string {
+	return fmt.Sprintf(`
+resource "aws_rds_cluster" "default" {
+  cluster_identifier      = "tf-aurora-cluster-%d"
+  master_username         = "foo"
+  master_password         = "mustbeeightcharaters"
+  backup_retention_period = 1
+  skip_final_snapshot     = true
+}
+
+resource "aws_rds_cluster" "restore" {
+  cluster_identifier      = "tf-aurora-cluster-%d-restore"
+  backup_retention_period = 3
+  skip_final_snapshot     = true
+
+  restore_to_point_in_time {
+    source_cluster_identifier  = "${aws_rds_cluster.default.cluster_identifier}"
+    restore_type               = "copy-on-write"
+    use_latest_restorable_time = true
+  }
+}`, n, n)
+}
+
 func testAccAWSClusterConfig_BacktrackWindow(backtrackWindow int) string {
 	return fmt.Sprintf(`
 resource "aws_rds_cluster" "test" {
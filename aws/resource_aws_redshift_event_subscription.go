@@ -0,0 +1,224 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsRedshiftEventSubscription() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRedshiftEventSubscriptionCreate,
+		Read:   resourceAwsRedshiftEventSubscriptionRead,
+		Update: resourceAwsRedshiftEventSubscriptionUpdate,
+		Delete: resourceAwsRedshiftEventSubscriptionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"sns_topic_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"source_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"cluster",
+					"cluster-parameter-group",
+					"cluster-security-group",
+					"cluster-snapshot",
+				}, false),
+			},
+			"source_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"event_categories": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"severity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ERROR", "INFO"}, false),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"customer_aws_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsRedshiftEventSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	name := d.Get("name").(string)
+	request := &redshift.CreateEventSubscriptionInput{
+		SubscriptionName: aws.String(name),
+		SnsTopicArn:      aws.String(d.Get("sns_topic_arn").(string)),
+		Enabled:          aws.Bool(d.Get("enabled").(bool)),
+		SourceIds:        expandStringList(d.Get("source_ids").(*schema.Set).List()),
+		EventCategories:  expandStringList(d.Get("event_categories").(*schema.Set).List()),
+		Tags:             tagsFromMapRedshift(d.Get("tags").(map[string]interface{})),
+	}
+	if v, ok := d.GetOk("source_type"); ok {
+		request.SourceType = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("severity"); ok {
+		request.Severity = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Redshift Event Subscription: %s", request)
+	_, err := conn.CreateEventSubscription(request)
+	if err != nil {
+		return fmt.Errorf("Error creating Redshift Event Subscription %s: %s", name, err)
+	}
+	d.SetId(name)
+
+	return resourceAwsRedshiftEventSubscriptionRead(d, meta)
+}
+
+func resourceAwsRedshiftEventSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	resp, err := conn.DescribeEventSubscriptions(&redshift.DescribeEventSubscriptionsInput{
+		SubscriptionName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, redshift.ErrCodeSubscriptionNotFoundFault, "") {
+			log.Printf("[WARN] Redshift Event Subscription (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Redshift Event Subscription %s: %s", d.Id(), err)
+	}
+	if len(resp.EventSubscriptionsList) == 0 {
+		log.Printf("[WARN] Redshift Event Subscription (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	sub := resp.EventSubscriptionsList[0]
+
+	d.Set("arn", redshiftEventSubscriptionArn(d.Id(), meta))
+	d.Set("name", sub.CustSubscriptionId)
+	d.Set("sns_topic_arn", sub.SnsTopicArn)
+	d.Set("source_type", sub.SourceType)
+	d.Set("severity", sub.Severity)
+	d.Set("enabled", sub.Enabled)
+	d.Set("customer_aws_id", sub.CustomerAwsId)
+	d.Set("status", sub.Status)
+	if err := d.Set("source_ids", flattenStringList(sub.SourceIdsList)); err != nil {
+		return fmt.Errorf("Error setting source_ids: %s", err)
+	}
+	if err := d.Set("event_categories", flattenStringList(sub.EventCategoriesList)); err != nil {
+		return fmt.Errorf("Error setting event_categories: %s", err)
+	}
+	if err := d.Set("tags", tagsToMapRedshift(sub.Tags)); err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsRedshiftEventSubscriptionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	d.Partial(true)
+
+	if d.HasChange("sns_topic_arn") || d.HasChange("source_type") || d.HasChange("source_ids") ||
+		d.HasChange("event_categories") || d.HasChange("severity") || d.HasChange("enabled") {
+		// Source IDs and event categories replace the existing lists, so
+		// the full configuration is sent with every modification.
+		request := &redshift.ModifyEventSubscriptionInput{
+			SubscriptionName: aws.String(d.Id()),
+			SnsTopicArn:      aws.String(d.Get("sns_topic_arn").(string)),
+			Enabled:          aws.Bool(d.Get("enabled").(bool)),
+			SourceIds:        expandStringList(d.Get("source_ids").(*schema.Set).List()),
+			EventCategories:  expandStringList(d.Get("event_categories").(*schema.Set).List()),
+			SourceType:       aws.String(d.Get("source_type").(string)),
+			Severity:         aws.String(d.Get("severity").(string)),
+		}
+
+		log.Printf("[DEBUG] Modifying Redshift Event Subscription: %s", request)
+		if _, err := conn.ModifyEventSubscription(request); err != nil {
+			return fmt.Errorf("Error modifying Redshift Event Subscription %s: %s", d.Id(), err)
+		}
+
+		d.SetPartial("sns_topic_arn")
+		d.SetPartial("source_type")
+		d.SetPartial("source_ids")
+		d.SetPartial("event_categories")
+		d.SetPartial("severity")
+		d.SetPartial("enabled")
+	}
+
+	if err := setTagsRedshift(conn, d, redshiftEventSubscriptionArn(d.Id(), meta)); err != nil {
+		return fmt.Errorf("Error updating Redshift Event Subscription (%s) tags: %s", d.Id(), err)
+	}
+	d.SetPartial("tags")
+
+	d.Partial(false)
+
+	return resourceAwsRedshiftEventSubscriptionRead(d, meta)
+}
+
+func resourceAwsRedshiftEventSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	log.Printf("[DEBUG] Deleting Redshift Event Subscription: %s", d.Id())
+	_, err := conn.DeleteEventSubscription(&redshift.DeleteEventSubscriptionInput{
+		SubscriptionName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, redshift.ErrCodeSubscriptionNotFoundFault, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Redshift Event Subscription %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func redshiftEventSubscriptionArn(name string, meta interface{}) string {
+	return arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "redshift",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("eventsubscription:%s", name),
+	}.String()
+}
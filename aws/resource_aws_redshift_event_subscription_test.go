@@ -0,0 +1,130 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSRedshiftEventSubscription_basic(t *testing.T) {
+	var v redshift.EventSubscription
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_redshift_event_subscription.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRedshiftEventSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRedshiftEventSubscriptionConfig(rName, "INFO", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftEventSubscriptionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "source_type", "cluster"),
+					resource.TestCheckResourceAttr(resourceName, "severity", "INFO"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "event_categories.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "status", "active"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "sns_topic_arn", "aws_sns_topic.test", "arn"),
+				),
+			},
+			{
+				Config: testAccAWSRedshiftEventSubscriptionConfig(rName, "ERROR", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftEventSubscriptionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "severity", "ERROR"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSRedshiftEventSubscriptionExists(n string, v *redshift.EventSubscription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Redshift Event Subscription name is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+		resp, err := conn.DescribeEventSubscriptions(&redshift.DescribeEventSubscriptionsInput{
+			SubscriptionName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+		if len(resp.EventSubscriptionsList) == 0 {
+			return fmt.Errorf("Redshift Event Subscription %s not found", rs.Primary.ID)
+		}
+
+		*v = *resp.EventSubscriptionsList[0]
+		return nil
+	}
+}
+
+func testAccCheckAWSRedshiftEventSubscriptionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshift_event_subscription" {
+			continue
+		}
+
+		resp, err := conn.DescribeEventSubscriptions(&redshift.DescribeEventSubscriptionsInput{
+			SubscriptionName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, redshift.ErrCodeSubscriptionNotFoundFault, "") {
+				continue
+			}
+			return err
+		}
+		if len(resp.EventSubscriptionsList) > 0 {
+			return fmt.Errorf("Redshift Event Subscription %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSRedshiftEventSubscriptionConfig(rName, severity string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = "%[1]s"
+}
+
+resource "aws_redshift_event_subscription" "test" {
+  name          = "%[1]s"
+  sns_topic_arn = "${aws_sns_topic.test.arn}"
+  source_type   = "cluster"
+  severity      = "%[2]s"
+  enabled       = %[3]t
+
+  event_categories = [
+    "configuration",
+    "management",
+  ]
+
+  tags {
+    Name = "%[1]s"
+  }
+}
+`, rName, severity, enabled)
+}
@@ -0,0 +1,161 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsRedshiftSnapshotCopyGrant() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRedshiftSnapshotCopyGrantCreate,
+		Read:   resourceAwsRedshiftSnapshotCopyGrantRead,
+		Update: resourceAwsRedshiftSnapshotCopyGrantUpdate,
+		Delete: resourceAwsRedshiftSnapshotCopyGrantDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"snapshot_copy_grant_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsRedshiftSnapshotCopyGrantCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	grantName := d.Get("snapshot_copy_grant_name").(string)
+	input := &redshift.CreateSnapshotCopyGrantInput{
+		SnapshotCopyGrantName: aws.String(grantName),
+		Tags:                  tagsFromMapRedshift(d.Get("tags").(map[string]interface{})),
+	}
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Redshift Snapshot Copy Grant: %s", input)
+	_, err := conn.CreateSnapshotCopyGrant(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Redshift Snapshot Copy Grant %s: %s", grantName, err)
+	}
+	d.SetId(grantName)
+
+	// The grant is not always visible right after it has been created
+	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+		grant, err := describeRedshiftSnapshotCopyGrant(conn, d.Id())
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if grant == nil {
+			return resource.RetryableError(fmt.Errorf("Redshift Snapshot Copy Grant %s not found yet", d.Id()))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceAwsRedshiftSnapshotCopyGrantRead(d, meta)
+}
+
+func resourceAwsRedshiftSnapshotCopyGrantRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	grant, err := describeRedshiftSnapshotCopyGrant(conn, d.Id())
+	if err != nil {
+		return err
+	}
+	if grant == nil {
+		log.Printf("[WARN] Redshift Snapshot Copy Grant (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("snapshot_copy_grant_name", grant.SnapshotCopyGrantName)
+	d.Set("kms_key_id", grant.KmsKeyId)
+	d.Set("arn", redshiftSnapshotCopyGrantArn(d.Id(), meta))
+	d.Set("tags", tagsToMapRedshift(grant.Tags))
+
+	return nil
+}
+
+func resourceAwsRedshiftSnapshotCopyGrantUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	if err := setTagsRedshift(conn, d, redshiftSnapshotCopyGrantArn(d.Id(), meta)); err != nil {
+		return fmt.Errorf("Error updating Redshift Snapshot Copy Grant (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsRedshiftSnapshotCopyGrantRead(d, meta)
+}
+
+func resourceAwsRedshiftSnapshotCopyGrantDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	log.Printf("[DEBUG] Deleting Redshift Snapshot Copy Grant: %s", d.Id())
+	_, err := conn.DeleteSnapshotCopyGrant(&redshift.DeleteSnapshotCopyGrantInput{
+		SnapshotCopyGrantName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, redshift.ErrCodeSnapshotCopyGrantNotFoundFault, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Redshift Snapshot Copy Grant %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// describeRedshiftSnapshotCopyGrant returns the grant with the given name, or
+// nil if it does not exist.
+func describeRedshiftSnapshotCopyGrant(conn *redshift.Redshift, name string) (*redshift.SnapshotCopyGrant, error) {
+	resp, err := conn.DescribeSnapshotCopyGrants(&redshift.DescribeSnapshotCopyGrantsInput{
+		SnapshotCopyGrantName: aws.String(name),
+	})
+	if err != nil {
+		if isAWSErr(err, redshift.ErrCodeSnapshotCopyGrantNotFoundFault, "") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Error retrieving Redshift Snapshot Copy Grant %s: %s", name, err)
+	}
+
+	for _, grant := range resp.SnapshotCopyGrants {
+		if aws.StringValue(grant.SnapshotCopyGrantName) == name {
+			return grant, nil
+		}
+	}
+	return nil, nil
+}
+
+func redshiftSnapshotCopyGrantArn(name string, meta interface{}) string {
+	return arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "redshift",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("snapshotcopygrant:%s", name),
+	}.String()
+}
@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSRedshiftSnapshotCopyGrant_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_redshift_snapshot_copy_grant.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRedshiftSnapshotCopyGrantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRedshiftSnapshotCopyGrantConfig(rName, "one"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftSnapshotCopyGrantExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_copy_grant_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "one"),
+				),
+			},
+			{
+				Config: testAccAWSRedshiftSnapshotCopyGrantConfig(rName, "two"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftSnapshotCopyGrantExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "two"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSRedshiftSnapshotCopyGrantExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Redshift Snapshot Copy Grant name is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+		grant, err := describeRedshiftSnapshotCopyGrant(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if grant == nil {
+			return fmt.Errorf("Redshift Snapshot Copy Grant %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSRedshiftSnapshotCopyGrantDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshift_snapshot_copy_grant" {
+			continue
+		}
+
+		grant, err := describeRedshiftSnapshotCopyGrant(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if grant != nil {
+			return fmt.Errorf("Redshift Snapshot Copy Grant %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSRedshiftSnapshotCopyGrantConfig(rName, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = "%[1]s"
+  deletion_window_in_days = 7
+}
+
+resource "aws_redshift_snapshot_copy_grant" "test" {
+  snapshot_copy_grant_name = "%[1]s"
+  kms_key_id               = "${aws_kms_key.test.arn}"
+
+  tags {
+    Name = "%[2]s"
+  }
+}
+`, rName, tagValue)
+}
@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -77,41 +78,16 @@ func resourceAwsS3Bucket() *schema.Resource {
 			},
 
 			"cors_rule": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"allowed_headers": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"allowed_methods": {
-							Type:     schema.TypeList,
-							Required: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"allowed_origins": {
-							Type:     schema.TypeList,
-							Required: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"expose_headers": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"max_age_seconds": {
-							Type:     schema.TypeInt,
-							Optional: true,
-						},
-					},
-				},
+				Type:             schema.TypeList,
+				Optional:         true,
+				DiffSuppressFunc: suppressS3BucketStandaloneConfigurationDiff("cors_rule"),
+				Elem:             resourceAwsS3BucketCorsRule(),
 			},
 
 			"website": {
-				Type:     schema.TypeList,
-				Optional: true,
+				Type:             schema.TypeList,
+				Optional:         true,
+				DiffSuppressFunc: suppressS3BucketStandaloneConfigurationDiff("website"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"index_document": {
@@ -170,10 +146,11 @@ func resourceAwsS3Bucket() *schema.Resource {
 			},
 
 			"versioning": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Computed: true,
-				MaxItems: 1,
+				Type:             schema.TypeList,
+				Optional:         true,
+				Computed:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: suppressS3BucketStandaloneConfigurationDiff("versioning"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"enabled": {
@@ -191,8 +168,9 @@ func resourceAwsS3Bucket() *schema.Resource {
 			},
 
 			"logging": {
-				Type:     schema.TypeSet,
-				Optional: true,
+				Type:             schema.TypeSet,
+				Optional:         true,
+				DiffSuppressFunc: suppressS3BucketStandaloneConfigurationDiff("logging"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"target_bucket": {
@@ -215,111 +193,10 @@ func resourceAwsS3Bucket() *schema.Resource {
 			},
 
 			"lifecycle_rule": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validateMaxLength(255),
-						},
-						"prefix": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"tags": tagsSchema(),
-						"enabled": {
-							Type:     schema.TypeBool,
-							Required: true,
-						},
-						"abort_incomplete_multipart_upload_days": {
-							Type:     schema.TypeInt,
-							Optional: true,
-						},
-						"expiration": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Set:      expirationHash,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"date": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: validateS3BucketLifecycleTimestamp,
-									},
-									"days": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(0),
-									},
-									"expired_object_delete_marker": {
-										Type:     schema.TypeBool,
-										Optional: true,
-									},
-								},
-							},
-						},
-						"noncurrent_version_expiration": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Set:      expirationHash,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"days": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-								},
-							},
-						},
-						"transition": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Set:      transitionHash,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"date": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: validateS3BucketLifecycleTimestamp,
-									},
-									"days": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(0),
-									},
-									"storage_class": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validateS3BucketLifecycleStorageClass(),
-									},
-								},
-							},
-						},
-						"noncurrent_version_transition": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Set:      transitionHash,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"days": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(0),
-									},
-									"storage_class": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validateS3BucketLifecycleStorageClass(),
-									},
-								},
-							},
-						},
-					},
-				},
+				Type:             schema.TypeList,
+				Optional:         true,
+				DiffSuppressFunc: suppressS3BucketStandaloneConfigurationDiff("lifecycle_rule"),
+				Elem:             resourceAwsS3BucketLifecycleRule(),
 			},
 
 			"force_destroy": {
@@ -349,9 +226,10 @@ func resourceAwsS3Bucket() *schema.Resource {
 			},
 
 			"replication_configuration": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:             schema.TypeList,
+				Optional:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: suppressS3BucketStandaloneConfigurationDiff("replication_configuration"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"role": {
@@ -362,196 +240,370 @@ func resourceAwsS3Bucket() *schema.Resource {
 							Type:     schema.TypeSet,
 							Required: true,
 							Set:      rulesHash,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"id": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: validateMaxLength(255),
-									},
-									"destination": {
-										Type:     schema.TypeSet,
-										MaxItems: 1,
-										MinItems: 1,
-										Required: true,
-										Set:      destinationHash,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"bucket": {
-													Type:         schema.TypeString,
-													Required:     true,
-													ValidateFunc: validateArn,
-												},
-												"storage_class": {
-													Type:     schema.TypeString,
-													Optional: true,
-													ValidateFunc: validation.StringInSlice([]string{
-														s3.StorageClassStandard,
-														s3.StorageClassOnezoneIa,
-														s3.StorageClassStandardIa,
-														s3.StorageClassReducedRedundancy,
-													}, false),
-												},
-												"replica_kms_key_id": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-											},
-										},
-									},
-									"source_selection_criteria": {
-										Type:     schema.TypeSet,
-										Optional: true,
-										MinItems: 1,
-										MaxItems: 1,
-										Set:      sourceSelectionCriteriaHash,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"sse_kms_encrypted_objects": {
-													Type:     schema.TypeSet,
-													Optional: true,
-													MinItems: 1,
-													MaxItems: 1,
-													Set:      sourceSseKmsObjectsHash,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"enabled": {
-																Type:     schema.TypeBool,
-																Required: true,
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-									"prefix": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validateMaxLength(1024),
-									},
-									"status": {
-										Type:     schema.TypeString,
-										Required: true,
-										ValidateFunc: validation.StringInSlice([]string{
-											s3.ReplicationRuleStatusEnabled,
-											s3.ReplicationRuleStatusDisabled,
-										}, false),
-									},
-								},
-							},
+							Elem:     resourceAwsS3BucketReplicationRule(),
 						},
 					},
 				},
 			},
 
 			"server_side_encryption_configuration": {
-				Type:     schema.TypeList,
-				MaxItems: 1,
-				Optional: true,
+				Type:             schema.TypeList,
+				MaxItems:         1,
+				Optional:         true,
+				DiffSuppressFunc: suppressS3BucketStandaloneConfigurationDiff("server_side_encryption_configuration"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"rule": {
 							Type:     schema.TypeList,
 							MaxItems: 1,
 							Required: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"apply_server_side_encryption_by_default": {
-										Type:     schema.TypeList,
-										MaxItems: 1,
-										Required: true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"kms_master_key_id": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"sse_algorithm": {
-													Type:     schema.TypeString,
-													Required: true,
-													ValidateFunc: validation.StringInSlice([]string{
-														s3.ServerSideEncryptionAes256,
-														s3.ServerSideEncryptionAwsKms,
-													}, false),
-												},
-											},
-										},
-									},
-								},
-							},
+							Elem:     resourceAwsS3BucketServerSideEncryptionRule(),
 						},
 					},
 				},
 			},
 
+			"standalone_configurations": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"cors_rule",
+						"lifecycle_rule",
+						"logging",
+						"replication_configuration",
+						"server_side_encryption_configuration",
+						"versioning",
+						"website",
+					}, false),
+				},
+				Set: schema.HashString,
+			},
+
 			"tags": tagsSchema(),
 		},
 	}
 }
 
-func resourceAwsS3BucketCreate(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
-
-	// Get the bucket and acl
-	var bucket string
-	if v, ok := d.GetOk("bucket"); ok {
-		bucket = v.(string)
-	} else if v, ok := d.GetOk("bucket_prefix"); ok {
-		bucket = resource.PrefixedUniqueId(v.(string))
-	} else {
-		bucket = resource.UniqueId()
-	}
-	d.Set("bucket", bucket)
-	acl := d.Get("acl").(string)
-
-	log.Printf("[DEBUG] S3 bucket create: %s, ACL: %s", bucket, acl)
-
-	req := &s3.CreateBucketInput{
-		Bucket: aws.String(bucket),
-		ACL:    aws.String(acl),
-	}
-
-	var awsRegion string
-	if region, ok := d.GetOk("region"); ok {
-		awsRegion = region.(string)
-	} else {
-		awsRegion = meta.(*AWSClient).region
-	}
-	log.Printf("[DEBUG] S3 bucket create: %s, using region: %s", bucket, awsRegion)
-
-	// Special case us-east-1 region and do not set the LocationConstraint.
-	// See "Request Elements: http://docs.aws.amazon.com/AmazonS3/latest/API/RESTBucketPUT.html
-	if awsRegion != "us-east-1" {
-		req.CreateBucketConfiguration = &s3.CreateBucketConfiguration{
-			LocationConstraint: aws.String(awsRegion),
-		}
+// suppressS3BucketStandaloneConfigurationDiff ignores differences in an inline
+// bucket configuration argument once it has been listed in
+// standalone_configurations, as the setting is then managed by the matching
+// standalone resource.
+func suppressS3BucketStandaloneConfigurationDiff(name string) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		return d.Get("standalone_configurations").(*schema.Set).Contains(name)
 	}
+}
 
-	if err := validateS3BucketName(bucket, awsRegion); err != nil {
-		return fmt.Errorf("Error validating S3 bucket name: %s", err)
+func resourceAwsS3BucketCorsRule() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"allowed_headers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"allowed_methods": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"allowed_origins": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"expose_headers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"max_age_seconds": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+		},
 	}
+}
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-		log.Printf("[DEBUG] Trying to create new S3 bucket: %q", bucket)
-		_, err := s3conn.CreateBucket(req)
-		if awsErr, ok := err.(awserr.Error); ok {
-			if awsErr.Code() == "OperationAborted" {
-				log.Printf("[WARN] Got an error while trying to create S3 bucket %s: %s", bucket, err)
-				return resource.RetryableError(
-					fmt.Errorf("[WARN] Error creating S3 bucket %s, retrying: %s",
-						bucket, err))
-			}
-		}
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
-	})
-
-	if err != nil {
-		return fmt.Errorf("Error creating S3 bucket: %s", err)
+func resourceAwsS3BucketLifecycleRule() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateMaxLength(255),
+			},
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": tagsSchema(),
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"abort_incomplete_multipart_upload_days": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"expiration": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      expirationHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"date": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateS3BucketLifecycleTimestamp,
+						},
+						"days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"expired_object_delete_marker": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"noncurrent_version_expiration": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      expirationHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"transition": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      transitionHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"date": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateS3BucketLifecycleTimestamp,
+						},
+						"days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"storage_class": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateS3BucketLifecycleStorageClass(),
+						},
+					},
+				},
+			},
+			"noncurrent_version_transition": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      transitionHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"storage_class": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateS3BucketLifecycleStorageClass(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketReplicationRule() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateMaxLength(255),
+			},
+			"destination": {
+				Type:     schema.TypeSet,
+				MaxItems: 1,
+				MinItems: 1,
+				Required: true,
+				Set:      destinationHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"storage_class": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								s3.StorageClassStandard,
+								s3.StorageClassOnezoneIa,
+								s3.StorageClassStandardIa,
+								s3.StorageClassReducedRedundancy,
+							}, false),
+						},
+						"replica_kms_key_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"source_selection_criteria": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Set:      sourceSelectionCriteriaHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sse_kms_encrypted_objects": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 1,
+							Set:      sourceSseKmsObjectsHash,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"prefix": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateMaxLength(1024),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ReplicationRuleStatusEnabled,
+					s3.ReplicationRuleStatusDisabled,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketServerSideEncryptionRule() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"apply_server_side_encryption_by_default": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_master_key_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"sse_algorithm": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								s3.ServerSideEncryptionAes256,
+								s3.ServerSideEncryptionAwsKms,
+							}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketCreate(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	// Get the bucket and acl
+	var bucket string
+	if v, ok := d.GetOk("bucket"); ok {
+		bucket = v.(string)
+	} else if v, ok := d.GetOk("bucket_prefix"); ok {
+		bucket = resource.PrefixedUniqueId(v.(string))
+	} else {
+		bucket = resource.UniqueId()
+	}
+	d.Set("bucket", bucket)
+	acl := d.Get("acl").(string)
+
+	log.Printf("[DEBUG] S3 bucket create: %s, ACL: %s", bucket, acl)
+
+	req := &s3.CreateBucketInput{
+		Bucket: aws.String(bucket),
+		ACL:    aws.String(acl),
+	}
+
+	var awsRegion string
+	if region, ok := d.GetOk("region"); ok {
+		awsRegion = region.(string)
+	} else {
+		awsRegion = meta.(*AWSClient).region
+	}
+	log.Printf("[DEBUG] S3 bucket create: %s, using region: %s", bucket, awsRegion)
+
+	// Special case us-east-1 region and do not set the LocationConstraint.
+	// See "Request Elements: http://docs.aws.amazon.com/AmazonS3/latest/API/RESTBucketPUT.html
+	if awsRegion != "us-east-1" {
+		req.CreateBucketConfiguration = &s3.CreateBucketConfiguration{
+			LocationConstraint: aws.String(awsRegion),
+		}
+	}
+
+	if err := validateS3BucketName(bucket, awsRegion); err != nil {
+		return fmt.Errorf("Error validating S3 bucket name: %s", err)
+	}
+
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		log.Printf("[DEBUG] Trying to create new S3 bucket: %q", bucket)
+		_, err := s3conn.CreateBucket(req)
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == "OperationAborted" {
+				log.Printf("[WARN] Got an error while trying to create S3 bucket %s: %s", bucket, err)
+				return resource.RetryableError(
+					fmt.Errorf("[WARN] Error creating S3 bucket %s, retrying: %s",
+						bucket, err))
+			}
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("Error creating S3 bucket: %s", err)
 	}
 
 	// Assign the bucket name as the resource ID
@@ -656,438 +708,320 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// In the import case, we won't have this
-	if _, ok := d.GetOk("bucket"); !ok {
+	_, ok := d.GetOk("bucket")
+	importing := !ok
+	if importing {
 		d.Set("bucket", d.Id())
 	}
 
 	d.Set("bucket_domain_name", bucketDomainName(d.Get("bucket").(string)))
 
+	// Existing buckets only refresh the configuration tracked in state; a
+	// new or imported bucket has nothing to go by, so everything is read.
+	readAll := d.IsNewResource() || importing
+	shouldRead := func(k string) bool {
+		if readAll {
+			return true
+		}
+		_, ok := d.GetOk(k)
+		return ok
+	}
+
+	bucket := d.Id()
+	var reads []*s3BucketSubRead
+
 	// Read the policy
 	if _, ok := d.GetOk("policy"); ok {
-
-		pol, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
-			return s3conn.GetBucketPolicy(&s3.GetBucketPolicyInput{
-				Bucket: aws.String(d.Id()),
-			})
-		})
-		log.Printf("[DEBUG] S3 bucket: %s, read policy: %v", d.Id(), pol)
-		if err != nil {
-			if err := d.Set("policy", ""); err != nil {
-				return err
-			}
-		} else {
-			if v := pol.(*s3.GetBucketPolicyOutput).Policy; v == nil {
-				if err := d.Set("policy", ""); err != nil {
-					return err
+		reads = append(reads, &s3BucketSubRead{
+			fetch: func() (interface{}, error) {
+				return s3conn.GetBucketPolicy(&s3.GetBucketPolicyInput{
+					Bucket: aws.String(bucket),
+				})
+			},
+			set: func(pol interface{}, err error) error {
+				log.Printf("[DEBUG] S3 bucket: %s, read policy: %v", bucket, pol)
+				if err != nil {
+					return d.Set("policy", "")
+				}
+				v := pol.(*s3.GetBucketPolicyOutput).Policy
+				if v == nil {
+					return d.Set("policy", "")
 				}
-			} else {
 				policy, err := structure.NormalizeJsonString(*v)
 				if err != nil {
 					return errwrap.Wrapf("policy contains an invalid JSON: {{err}}", err)
 				}
 				d.Set("policy", policy)
-			}
-		}
+				return nil
+			},
+		})
 	}
 
 	// Read the CORS
-	corsResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
-		return s3conn.GetBucketCors(&s3.GetBucketCorsInput{
-			Bucket: aws.String(d.Id()),
+	if shouldRead("cors_rule") {
+		reads = append(reads, &s3BucketSubRead{
+			fetch: func() (interface{}, error) {
+				return s3conn.GetBucketCors(&s3.GetBucketCorsInput{
+					Bucket: aws.String(bucket),
+				})
+			},
+			set: func(corsResponse interface{}, err error) error {
+				if err != nil {
+					// An S3 Bucket might not have CORS configuration set.
+					if !isAWSErr(err, "NoSuchCORSConfiguration", "") {
+						return err
+					}
+					log.Printf("[WARN] S3 bucket: %s, no CORS configuration could be found.", bucket)
+				}
+				cors := corsResponse.(*s3.GetBucketCorsOutput)
+				log.Printf("[DEBUG] S3 bucket: %s, read CORS: %v", bucket, cors)
+				if cors.CORSRules != nil {
+					return d.Set("cors_rule", flattenS3CorsRules(cors.CORSRules))
+				}
+				return nil
+			},
 		})
-	})
-	if err != nil {
-		// An S3 Bucket might not have CORS configuration set.
-		if awsErr, ok := err.(awserr.Error); ok {
-			if awsErr.Code() != "NoSuchCORSConfiguration" {
-				return err
-			}
-			log.Printf("[WARN] S3 bucket: %s, no CORS configuration could be found.", d.Id())
-		} else {
-			return err
-		}
-	}
-	cors := corsResponse.(*s3.GetBucketCorsOutput)
-	log.Printf("[DEBUG] S3 bucket: %s, read CORS: %v", d.Id(), cors)
-	if cors.CORSRules != nil {
-		rules := make([]map[string]interface{}, 0, len(cors.CORSRules))
-		for _, ruleObject := range cors.CORSRules {
-			rule := make(map[string]interface{})
-			rule["allowed_headers"] = flattenStringList(ruleObject.AllowedHeaders)
-			rule["allowed_methods"] = flattenStringList(ruleObject.AllowedMethods)
-			rule["allowed_origins"] = flattenStringList(ruleObject.AllowedOrigins)
-			// Both the "ExposeHeaders" and "MaxAgeSeconds" might not be set.
-			if ruleObject.AllowedOrigins != nil {
-				rule["expose_headers"] = flattenStringList(ruleObject.ExposeHeaders)
-			}
-			if ruleObject.MaxAgeSeconds != nil {
-				rule["max_age_seconds"] = int(*ruleObject.MaxAgeSeconds)
-			}
-			rules = append(rules, rule)
-		}
-		if err := d.Set("cors_rule", rules); err != nil {
-			return err
-		}
 	}
 
 	// Read the website configuration
-	wsResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
-		return s3conn.GetBucketWebsite(&s3.GetBucketWebsiteInput{
-			Bucket: aws.String(d.Id()),
-		})
-	})
-	ws := wsResponse.(*s3.GetBucketWebsiteOutput)
-	var websites []map[string]interface{}
-	if err == nil {
-		w := make(map[string]interface{})
-
-		if v := ws.IndexDocument; v != nil {
-			w["index_document"] = *v.Suffix
-		}
-
-		if v := ws.ErrorDocument; v != nil {
-			w["error_document"] = *v.Key
-		}
-
-		if v := ws.RedirectAllRequestsTo; v != nil {
-			if v.Protocol == nil {
-				w["redirect_all_requests_to"] = *v.HostName
-			} else {
-				var host string
-				var path string
-				var query string
-				parsedHostName, err := url.Parse(*v.HostName)
+	if shouldRead("website") {
+		reads = append(reads, &s3BucketSubRead{
+			fetch: func() (interface{}, error) {
+				return s3conn.GetBucketWebsite(&s3.GetBucketWebsiteInput{
+					Bucket: aws.String(bucket),
+				})
+			},
+			set: func(wsResponse interface{}, err error) error {
+				var websites []map[string]interface{}
 				if err == nil {
-					host = parsedHostName.Host
-					path = parsedHostName.Path
-					query = parsedHostName.RawQuery
-				} else {
-					host = *v.HostName
-					path = ""
+					w, err := flattenS3WebsiteConfiguration(wsResponse.(*s3.GetBucketWebsiteOutput))
+					if err != nil {
+						return err
+					}
+					websites = append(websites, w)
 				}
-
-				w["redirect_all_requests_to"] = (&url.URL{
-					Host:     host,
-					Path:     path,
-					Scheme:   *v.Protocol,
-					RawQuery: query,
-				}).String()
-			}
-		}
-
-		if v := ws.RoutingRules; v != nil {
-			rr, err := normalizeRoutingRules(v)
-			if err != nil {
-				return fmt.Errorf("Error while marshaling routing rules: %s", err)
-			}
-			w["routing_rules"] = rr
-		}
-
-		websites = append(websites, w)
-	}
-	if err := d.Set("website", websites); err != nil {
-		return err
+				return d.Set("website", websites)
+			},
+		})
 	}
 
 	// Read the versioning configuration
-
-	versioningResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
-		return s3conn.GetBucketVersioning(&s3.GetBucketVersioningInput{
-			Bucket: aws.String(d.Id()),
+	if shouldRead("versioning") {
+		reads = append(reads, &s3BucketSubRead{
+			fetch: func() (interface{}, error) {
+				return s3conn.GetBucketVersioning(&s3.GetBucketVersioningInput{
+					Bucket: aws.String(bucket),
+				})
+			},
+			set: func(versioningResponse interface{}, err error) error {
+				if err != nil {
+					return err
+				}
+				versioning := versioningResponse.(*s3.GetBucketVersioningOutput)
+				log.Printf("[DEBUG] S3 Bucket: %s, versioning: %v", bucket, versioning)
+				return d.Set("versioning", flattenAwsS3BucketVersioning(versioning))
+			},
 		})
-	})
-	versioning := versioningResponse.(*s3.GetBucketVersioningOutput)
-	if err != nil {
-		return err
-	}
-	log.Printf("[DEBUG] S3 Bucket: %s, versioning: %v", d.Id(), versioning)
-	if versioning != nil {
-		vcl := make([]map[string]interface{}, 0, 1)
-		vc := make(map[string]interface{})
-		if versioning.Status != nil && *versioning.Status == s3.BucketVersioningStatusEnabled {
-			vc["enabled"] = true
-		} else {
-			vc["enabled"] = false
-		}
-
-		if versioning.MFADelete != nil && *versioning.MFADelete == s3.MFADeleteEnabled {
-			vc["mfa_delete"] = true
-		} else {
-			vc["mfa_delete"] = false
-		}
-		vcl = append(vcl, vc)
-		if err := d.Set("versioning", vcl); err != nil {
-			return err
-		}
 	}
 
 	// Read the acceleration status
+	if shouldRead("acceleration_status") {
+		reads = append(reads, &s3BucketSubRead{
+			fetch: func() (interface{}, error) {
+				return s3conn.GetBucketAccelerateConfiguration(&s3.GetBucketAccelerateConfigurationInput{
+					Bucket: aws.String(bucket),
+				})
+			},
+			set: func(accelerateResponse interface{}, err error) error {
+				if err != nil {
+					// Amazon S3 Transfer Acceleration might not be supported in the
+					// given region, for example, China (Beijing) and the Government
+					// Cloud does not support this feature at the moment.
+					if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() != "UnsupportedArgument" {
+						return err
+					}
 
-	accelerateResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
-		return s3conn.GetBucketAccelerateConfiguration(&s3.GetBucketAccelerateConfigurationInput{
-			Bucket: aws.String(d.Id()),
-		})
-	})
-	accelerate := accelerateResponse.(*s3.GetBucketAccelerateConfigurationOutput)
-	if err != nil {
-		// Amazon S3 Transfer Acceleration might not be supported in the
-		// given region, for example, China (Beijing) and the Government
-		// Cloud does not support this feature at the moment.
-		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() != "UnsupportedArgument" {
-			return err
-		}
-
-		var awsRegion string
-		if region, ok := d.GetOk("region"); ok {
-			awsRegion = region.(string)
-		} else {
-			awsRegion = meta.(*AWSClient).region
-		}
+					var awsRegion string
+					if region, ok := d.GetOk("region"); ok {
+						awsRegion = region.(string)
+					} else {
+						awsRegion = meta.(*AWSClient).region
+					}
 
-		log.Printf("[WARN] S3 bucket: %s, the S3 Transfer Acceleration is not supported in the region: %s", d.Id(), awsRegion)
-	} else {
-		log.Printf("[DEBUG] S3 bucket: %s, read Acceleration: %v", d.Id(), accelerate)
-		d.Set("acceleration_status", accelerate.Status)
+					log.Printf("[WARN] S3 bucket: %s, the S3 Transfer Acceleration is not supported in the region: %s", bucket, awsRegion)
+					return nil
+				}
+				accelerate := accelerateResponse.(*s3.GetBucketAccelerateConfigurationOutput)
+				log.Printf("[DEBUG] S3 bucket: %s, read Acceleration: %v", bucket, accelerate)
+				d.Set("acceleration_status", accelerate.Status)
+				return nil
+			},
+		})
 	}
 
 	// Read the request payer configuration.
-
-	payerResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
-		return s3conn.GetBucketRequestPayment(&s3.GetBucketRequestPaymentInput{
-			Bucket: aws.String(d.Id()),
+	if shouldRead("request_payer") {
+		reads = append(reads, &s3BucketSubRead{
+			fetch: func() (interface{}, error) {
+				return s3conn.GetBucketRequestPayment(&s3.GetBucketRequestPaymentInput{
+					Bucket: aws.String(bucket),
+				})
+			},
+			set: func(payerResponse interface{}, err error) error {
+				if err != nil {
+					return err
+				}
+				payer := payerResponse.(*s3.GetBucketRequestPaymentOutput)
+				log.Printf("[DEBUG] S3 Bucket: %s, read request payer: %v", bucket, payer)
+				if payer.Payer != nil {
+					return d.Set("request_payer", *payer.Payer)
+				}
+				return nil
+			},
 		})
-	})
-	payer := payerResponse.(*s3.GetBucketRequestPaymentOutput)
-	if err != nil {
-		return err
-	}
-	log.Printf("[DEBUG] S3 Bucket: %s, read request payer: %v", d.Id(), payer)
-	if payer.Payer != nil {
-		if err := d.Set("request_payer", *payer.Payer); err != nil {
-			return err
-		}
 	}
 
 	// Read the logging configuration
-	loggingResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
-		return s3conn.GetBucketLogging(&s3.GetBucketLoggingInput{
-			Bucket: aws.String(d.Id()),
+	if shouldRead("logging") {
+		reads = append(reads, &s3BucketSubRead{
+			fetch: func() (interface{}, error) {
+				return s3conn.GetBucketLogging(&s3.GetBucketLoggingInput{
+					Bucket: aws.String(bucket),
+				})
+			},
+			set: func(loggingResponse interface{}, err error) error {
+				if err != nil {
+					return err
+				}
+				logging := loggingResponse.(*s3.GetBucketLoggingOutput)
+				log.Printf("[DEBUG] S3 Bucket: %s, logging: %v", bucket, logging)
+				return d.Set("logging", flattenAwsS3BucketLogging(logging.LoggingEnabled))
+			},
 		})
-	})
-	logging := loggingResponse.(*s3.GetBucketLoggingOutput)
-	if err != nil {
-		return err
-	}
-
-	log.Printf("[DEBUG] S3 Bucket: %s, logging: %v", d.Id(), logging)
-	lcl := make([]map[string]interface{}, 0, 1)
-	if v := logging.LoggingEnabled; v != nil {
-		lc := make(map[string]interface{})
-		if *v.TargetBucket != "" {
-			lc["target_bucket"] = *v.TargetBucket
-		}
-		if *v.TargetPrefix != "" {
-			lc["target_prefix"] = *v.TargetPrefix
-		}
-		lcl = append(lcl, lc)
-	}
-	if err := d.Set("logging", lcl); err != nil {
-		return err
 	}
 
 	// Read the lifecycle configuration
-
-	lifecycleResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
-		return s3conn.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
-			Bucket: aws.String(d.Id()),
+	if shouldRead("lifecycle_rule") {
+		reads = append(reads, &s3BucketSubRead{
+			fetch: func() (interface{}, error) {
+				return s3conn.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
+					Bucket: aws.String(bucket),
+				})
+			},
+			set: func(lifecycleResponse interface{}, err error) error {
+				if err != nil {
+					if awsError, ok := err.(awserr.RequestFailure); ok && awsError.StatusCode() != 404 {
+						return err
+					}
+				}
+				if lifecycle, ok := lifecycleResponse.(*s3.GetBucketLifecycleConfigurationOutput); ok && len(lifecycle.Rules) > 0 {
+					log.Printf("[DEBUG] S3 Bucket: %s, lifecycle: %v", bucket, lifecycle)
+					return d.Set("lifecycle_rule", flattenAwsS3BucketLifecycleRules(lifecycle.Rules))
+				}
+				return nil
+			},
 		})
-	})
-	if err != nil {
-		if awsError, ok := err.(awserr.RequestFailure); ok && awsError.StatusCode() != 404 {
-			return err
-		}
 	}
-	if lifecycle, ok := lifecycleResponse.(*s3.GetBucketLifecycleConfigurationOutput); ok && len(lifecycle.Rules) > 0 {
-		log.Printf("[DEBUG] S3 Bucket: %s, lifecycle: %v", d.Id(), lifecycle)
-		rules := make([]map[string]interface{}, 0, len(lifecycle.Rules))
-
-		for _, lifecycleRule := range lifecycle.Rules {
-			rule := make(map[string]interface{})
 
-			// ID
-			if lifecycleRule.ID != nil && *lifecycleRule.ID != "" {
-				rule["id"] = *lifecycleRule.ID
-			}
-			filter := lifecycleRule.Filter
-			if filter != nil {
-				if filter.And != nil {
-					// Prefix
-					if filter.And.Prefix != nil && *filter.And.Prefix != "" {
-						rule["prefix"] = *filter.And.Prefix
-					}
-					// Tag
-					if len(filter.And.Tags) > 0 {
-						rule["tags"] = tagsToMapS3(filter.And.Tags)
-					}
-				} else {
-					// Prefix
-					if filter.Prefix != nil && *filter.Prefix != "" {
-						rule["prefix"] = *filter.Prefix
+	// Read the bucket replication configuration
+	if shouldRead("replication_configuration") {
+		reads = append(reads, &s3BucketSubRead{
+			fetch: func() (interface{}, error) {
+				return s3conn.GetBucketReplication(&s3.GetBucketReplicationInput{
+					Bucket: aws.String(bucket),
+				})
+			},
+			set: func(replicationResponse interface{}, err error) error {
+				if err != nil {
+					if awsError, ok := err.(awserr.RequestFailure); ok && awsError.StatusCode() != 404 {
+						return err
 					}
 				}
-			} else {
-				if lifecycleRule.Prefix != nil {
-					rule["prefix"] = *lifecycleRule.Prefix
-				}
-			}
-
-			// Enabled
-			if lifecycleRule.Status != nil {
-				if *lifecycleRule.Status == s3.ExpirationStatusEnabled {
-					rule["enabled"] = true
-				} else {
-					rule["enabled"] = false
+				var c *s3.ReplicationConfiguration
+				if replication, ok := replicationResponse.(*s3.GetBucketReplicationOutput); ok {
+					log.Printf("[DEBUG] S3 Bucket: %s, read replication configuration: %v", bucket, replication)
+					c = replication.ReplicationConfiguration
 				}
-			}
-
-			// AbortIncompleteMultipartUploadDays
-			if lifecycleRule.AbortIncompleteMultipartUpload != nil {
-				if lifecycleRule.AbortIncompleteMultipartUpload.DaysAfterInitiation != nil {
-					rule["abort_incomplete_multipart_upload_days"] = int(*lifecycleRule.AbortIncompleteMultipartUpload.DaysAfterInitiation)
+				if err := d.Set("replication_configuration", flattenAwsS3BucketReplicationConfiguration(c)); err != nil {
+					log.Printf("[DEBUG] Error setting replication configuration: %s", err)
+					return err
 				}
-			}
+				return nil
+			},
+		})
+	}
 
-			// expiration
-			if lifecycleRule.Expiration != nil {
-				e := make(map[string]interface{})
-				if lifecycleRule.Expiration.Date != nil {
-					e["date"] = (*lifecycleRule.Expiration.Date).Format("2006-01-02")
-				}
-				if lifecycleRule.Expiration.Days != nil {
-					e["days"] = int(*lifecycleRule.Expiration.Days)
-				}
-				if lifecycleRule.Expiration.ExpiredObjectDeleteMarker != nil {
-					e["expired_object_delete_marker"] = *lifecycleRule.Expiration.ExpiredObjectDeleteMarker
-				}
-				rule["expiration"] = schema.NewSet(expirationHash, []interface{}{e})
-			}
-			// noncurrent_version_expiration
-			if lifecycleRule.NoncurrentVersionExpiration != nil {
-				e := make(map[string]interface{})
-				if lifecycleRule.NoncurrentVersionExpiration.NoncurrentDays != nil {
-					e["days"] = int(*lifecycleRule.NoncurrentVersionExpiration.NoncurrentDays)
-				}
-				rule["noncurrent_version_expiration"] = schema.NewSet(expirationHash, []interface{}{e})
-			}
-			//// transition
-			if len(lifecycleRule.Transitions) > 0 {
-				transitions := make([]interface{}, 0, len(lifecycleRule.Transitions))
-				for _, v := range lifecycleRule.Transitions {
-					t := make(map[string]interface{})
-					if v.Date != nil {
-						t["date"] = (*v.Date).Format("2006-01-02")
-					}
-					if v.Days != nil {
-						t["days"] = int(*v.Days)
-					}
-					if v.StorageClass != nil {
-						t["storage_class"] = *v.StorageClass
+	// Read the bucket server side encryption configuration
+	if shouldRead("server_side_encryption_configuration") {
+		reads = append(reads, &s3BucketSubRead{
+			fetch: func() (interface{}, error) {
+				return s3conn.GetBucketEncryption(&s3.GetBucketEncryptionInput{
+					Bucket: aws.String(bucket),
+				})
+			},
+			set: func(encryptionResponse interface{}, err error) error {
+				if err != nil {
+					if isAWSErr(err, "ServerSideEncryptionConfigurationNotFoundError", "encryption configuration was not found") {
+						log.Printf("[DEBUG] Default encryption is not enabled for %s", bucket)
+						d.Set("server_side_encryption_configuration", []map[string]interface{}{})
+						return nil
 					}
-					transitions = append(transitions, t)
+					return err
 				}
-				rule["transition"] = schema.NewSet(transitionHash, transitions)
-			}
-			// noncurrent_version_transition
-			if len(lifecycleRule.NoncurrentVersionTransitions) > 0 {
-				transitions := make([]interface{}, 0, len(lifecycleRule.NoncurrentVersionTransitions))
-				for _, v := range lifecycleRule.NoncurrentVersionTransitions {
-					t := make(map[string]interface{})
-					if v.NoncurrentDays != nil {
-						t["days"] = int(*v.NoncurrentDays)
+				encryption := encryptionResponse.(*s3.GetBucketEncryptionOutput)
+				log.Printf("[DEBUG] S3 Bucket: %s, read encryption configuration: %v", bucket, encryption)
+				if c := encryption.ServerSideEncryptionConfiguration; c != nil {
+					if err := d.Set("server_side_encryption_configuration", flattenAwsS3ServerSideEncryptionConfiguration(c)); err != nil {
+						log.Printf("[DEBUG] Error setting server side encryption configuration: %s", err)
+						return err
 					}
-					if v.StorageClass != nil {
-						t["storage_class"] = *v.StorageClass
-					}
-					transitions = append(transitions, t)
 				}
-				rule["noncurrent_version_transition"] = schema.NewSet(transitionHash, transitions)
-			}
-
-			rules = append(rules, rule)
-		}
-
-		if err := d.Set("lifecycle_rule", rules); err != nil {
-			return err
-		}
-	}
-
-	// Read the bucket replication configuration
-
-	replicationResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
-		return s3conn.GetBucketReplication(&s3.GetBucketReplicationInput{
-			Bucket: aws.String(d.Id()),
+				return nil
+			},
 		})
-	})
-	if err != nil {
-		if awsError, ok := err.(awserr.RequestFailure); ok && awsError.StatusCode() != 404 {
-			return err
-		}
-	}
-	replication := replicationResponse.(*s3.GetBucketReplicationOutput)
-
-	log.Printf("[DEBUG] S3 Bucket: %s, read replication configuration: %v", d.Id(), replication)
-	if err := d.Set("replication_configuration", flattenAwsS3BucketReplicationConfiguration(replication.ReplicationConfiguration)); err != nil {
-		log.Printf("[DEBUG] Error setting replication configuration: %s", err)
-		return err
 	}
 
-	// Read the bucket server side encryption configuration
-
-	encryptionResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
-		return s3conn.GetBucketEncryption(&s3.GetBucketEncryptionInput{
-			Bucket: aws.String(d.Id()),
-		})
-	})
-	if err != nil {
-		if isAWSErr(err, "ServerSideEncryptionConfigurationNotFoundError", "encryption configuration was not found") {
-			log.Printf("[DEBUG] Default encryption is not enabled for %s", d.Id())
-			d.Set("server_side_encryption_configuration", []map[string]interface{}{})
-		} else {
-			return err
-		}
-	} else {
-		encryption := encryptionResponse.(*s3.GetBucketEncryptionOutput)
-		log.Printf("[DEBUG] S3 Bucket: %s, read encryption configuration: %v", d.Id(), encryption)
-		if c := encryption.ServerSideEncryptionConfiguration; c != nil {
-			if err := d.Set("server_side_encryption_configuration", flattenAwsS3ServerSideEncryptionConfiguration(c)); err != nil {
-				log.Printf("[DEBUG] Error setting server side encryption configuration: %s", err)
+	// Read the region, which the endpoint attributes below are derived from
+	var locationConstraint string
+	reads = append(reads, &s3BucketSubRead{
+		fetch: func() (interface{}, error) {
+			return s3conn.GetBucketLocation(&s3.GetBucketLocationInput{
+				Bucket: aws.String(bucket),
+			})
+		},
+		set: func(locationResponse interface{}, err error) error {
+			if err != nil {
 				return err
 			}
-		}
-	}
-
-	// Add the region as an attribute
+			location := locationResponse.(*s3.GetBucketLocationOutput)
+			if location.LocationConstraint != nil {
+				locationConstraint = *location.LocationConstraint
+			}
+			return d.Set("region", normalizeRegion(locationConstraint))
+		},
+	})
 
-	locationResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
-		return s3conn.GetBucketLocation(
-			&s3.GetBucketLocationInput{
-				Bucket: aws.String(d.Id()),
+	// Read the tags
+	if shouldRead("tags") {
+		reads = append(reads, &s3BucketSubRead{
+			fetch: func() (interface{}, error) {
+				return getTagSetS3(s3conn, bucket)
 			},
-		)
-	})
-	if err != nil {
-		return err
-	}
-	location := locationResponse.(*s3.GetBucketLocationOutput)
-	var region string
-	if location.LocationConstraint != nil {
-		region = *location.LocationConstraint
+			set: func(tagSet interface{}, err error) error {
+				if err != nil {
+					return err
+				}
+				return d.Set("tags", tagsToMapS3(tagSet.([]*s3.Tag)))
+			},
+		})
 	}
-	region = normalizeRegion(region)
-	if err := d.Set("region", region); err != nil {
+
+	if err := readS3BucketConcurrently(reads, s3BucketReadConcurrency); err != nil {
 		return err
 	}
 
+	region := d.Get("region").(string)
+
 	// Add the bucket_regional_domain_name as an attribute
 	regionalEndpoint, err := BucketRegionalDomainName(d.Get("bucket").(string), region)
 	if err != nil {
@@ -1104,11 +1038,8 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Add website_endpoint as an attribute
-	websiteEndpoint, err := websiteEndpoint(s3conn, d)
-	if err != nil {
-		return err
-	}
-	if websiteEndpoint != nil {
+	if _, ok := d.GetOk("website"); ok {
+		websiteEndpoint := WebsiteEndpoint(d.Get("bucket").(string), locationConstraint)
 		if err := d.Set("website_endpoint", websiteEndpoint.Endpoint); err != nil {
 			return err
 		}
@@ -1117,15 +1048,6 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	tagSet, err := getTagSetS3(s3conn, d.Id())
-	if err != nil {
-		return err
-	}
-
-	if err := d.Set("tags", tagsToMapS3(tagSet)); err != nil {
-		return err
-	}
-
 	arn := arn.ARN{
 		Partition: meta.(*AWSClient).partition,
 		Service:   "s3",
@@ -1136,6 +1058,53 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// s3BucketReadConcurrency is the maximum number of API calls made at the same
+// time while reading a single S3 bucket.
+const s3BucketReadConcurrency = 4
+
+// s3BucketSubRead is one of the API calls used to read an S3 bucket.
+// fetch may run concurrently with other fetches, set is always called from
+// the goroutine reading the bucket, in the order the sub-reads were given.
+type s3BucketSubRead struct {
+	fetch func() (interface{}, error)
+	set   func(interface{}, error) error
+}
+
+// readS3BucketConcurrently runs the fetch of every sub-read with at most
+// concurrency calls in flight, retrying while the bucket is not yet
+// visible, then passes each result to its set function.
+func readS3BucketConcurrently(reads []*s3BucketSubRead, concurrency int) error {
+	type result struct {
+		resp interface{}
+		err  error
+	}
+	results := make([]result, len(reads))
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, r := range reads {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, r *s3BucketSubRead) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			resp, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, r.fetch)
+			results[i] = result{resp, err}
+		}(i, r)
+	}
+	wg.Wait()
+
+	for i, r := range reads {
+		if err := r.set(results[i].resp, results[i].err); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func resourceAwsS3BucketDelete(d *schema.ResourceData, meta interface{}) error {
 	s3conn := meta.(*AWSClient).s3conn
 
@@ -1265,39 +1234,10 @@ func resourceAwsS3BucketCorsUpdate(s3conn *s3.S3, d *schema.ResourceData) error
 		}
 	} else {
 		// Put CORS
-		rules := make([]*s3.CORSRule, 0, len(rawCors))
-		for _, cors := range rawCors {
-			corsMap := cors.(map[string]interface{})
-			r := &s3.CORSRule{}
-			for k, v := range corsMap {
-				log.Printf("[DEBUG] S3 bucket: %s, put CORS: %#v, %#v", bucket, k, v)
-				if k == "max_age_seconds" {
-					r.MaxAgeSeconds = aws.Int64(int64(v.(int)))
-				} else {
-					vMap := make([]*string, len(v.([]interface{})))
-					for i, vv := range v.([]interface{}) {
-						if str, ok := vv.(string); ok {
-							vMap[i] = aws.String(str)
-						}
-					}
-					switch k {
-					case "allowed_headers":
-						r.AllowedHeaders = vMap
-					case "allowed_methods":
-						r.AllowedMethods = vMap
-					case "allowed_origins":
-						r.AllowedOrigins = vMap
-					case "expose_headers":
-						r.ExposeHeaders = vMap
-					}
-				}
-			}
-			rules = append(rules, r)
-		}
 		corsInput := &s3.PutBucketCorsInput{
 			Bucket: aws.String(bucket),
 			CORSConfiguration: &s3.CORSConfiguration{
-				CORSRules: rules,
+				CORSRules: expandS3CorsRules(rawCors),
 			},
 		}
 		log.Printf("[DEBUG] S3 bucket: %s, put CORS: %#v", bucket, corsInput)
@@ -1313,6 +1253,57 @@ func resourceAwsS3BucketCorsUpdate(s3conn *s3.S3, d *schema.ResourceData) error
 	return nil
 }
 
+func expandS3CorsRules(rawCors []interface{}) []*s3.CORSRule {
+	rules := make([]*s3.CORSRule, 0, len(rawCors))
+	for _, cors := range rawCors {
+		corsMap := cors.(map[string]interface{})
+		r := &s3.CORSRule{}
+		for k, v := range corsMap {
+			if k == "max_age_seconds" {
+				r.MaxAgeSeconds = aws.Int64(int64(v.(int)))
+			} else {
+				vMap := make([]*string, len(v.([]interface{})))
+				for i, vv := range v.([]interface{}) {
+					if str, ok := vv.(string); ok {
+						vMap[i] = aws.String(str)
+					}
+				}
+				switch k {
+				case "allowed_headers":
+					r.AllowedHeaders = vMap
+				case "allowed_methods":
+					r.AllowedMethods = vMap
+				case "allowed_origins":
+					r.AllowedOrigins = vMap
+				case "expose_headers":
+					r.ExposeHeaders = vMap
+				}
+			}
+		}
+		rules = append(rules, r)
+	}
+	return rules
+}
+
+func flattenS3CorsRules(corsRules []*s3.CORSRule) []map[string]interface{} {
+	rules := make([]map[string]interface{}, 0, len(corsRules))
+	for _, ruleObject := range corsRules {
+		rule := make(map[string]interface{})
+		rule["allowed_headers"] = flattenStringList(ruleObject.AllowedHeaders)
+		rule["allowed_methods"] = flattenStringList(ruleObject.AllowedMethods)
+		rule["allowed_origins"] = flattenStringList(ruleObject.AllowedOrigins)
+		// Both the "ExposeHeaders" and "MaxAgeSeconds" might not be set.
+		if ruleObject.AllowedOrigins != nil {
+			rule["expose_headers"] = flattenStringList(ruleObject.ExposeHeaders)
+		}
+		if ruleObject.MaxAgeSeconds != nil {
+			rule["max_age_seconds"] = int(*ruleObject.MaxAgeSeconds)
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
 func resourceAwsS3BucketWebsiteUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
 	ws := d.Get("website").([]interface{})
 
@@ -1334,6 +1325,32 @@ func resourceAwsS3BucketWebsiteUpdate(s3conn *s3.S3, d *schema.ResourceData) err
 func resourceAwsS3BucketWebsitePut(s3conn *s3.S3, d *schema.ResourceData, website map[string]interface{}) error {
 	bucket := d.Get("bucket").(string)
 
+	websiteConfiguration, err := expandS3WebsiteConfiguration(website)
+	if err != nil {
+		return err
+	}
+
+	putInput := &s3.PutBucketWebsiteInput{
+		Bucket:               aws.String(bucket),
+		WebsiteConfiguration: websiteConfiguration,
+	}
+
+	log.Printf("[DEBUG] S3 put bucket website: %#v", putInput)
+
+	_, err = retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		return s3conn.PutBucketWebsite(putInput)
+	})
+	if err != nil {
+		return fmt.Errorf("Error putting S3 website: %s", err)
+	}
+
+	return nil
+}
+
+// expandS3WebsiteConfiguration builds the website configuration from the
+// index_document, error_document, redirect_all_requests_to and routing_rules
+// arguments shared by aws_s3_bucket and aws_s3_bucket_website_configuration.
+func expandS3WebsiteConfiguration(website map[string]interface{}) (*s3.WebsiteConfiguration, error) {
 	var indexDocument, errorDocument, redirectAllRequestsTo, routingRules string
 	if v, ok := website["index_document"]; ok {
 		indexDocument = v.(string)
@@ -1349,7 +1366,7 @@ func resourceAwsS3BucketWebsitePut(s3conn *s3.S3, d *schema.ResourceData, websit
 	}
 
 	if indexDocument == "" && redirectAllRequestsTo == "" {
-		return fmt.Errorf("Must specify either index_document or redirect_all_requests_to.")
+		return nil, fmt.Errorf("Must specify either index_document or redirect_all_requests_to.")
 	}
 
 	websiteConfiguration := &s3.WebsiteConfiguration{}
@@ -1383,26 +1400,60 @@ func resourceAwsS3BucketWebsitePut(s3conn *s3.S3, d *schema.ResourceData, websit
 	if routingRules != "" {
 		var unmarshaledRules []*s3.RoutingRule
 		if err := json.Unmarshal([]byte(routingRules), &unmarshaledRules); err != nil {
-			return err
+			return nil, err
 		}
 		websiteConfiguration.RoutingRules = unmarshaledRules
 	}
 
-	putInput := &s3.PutBucketWebsiteInput{
-		Bucket:               aws.String(bucket),
-		WebsiteConfiguration: websiteConfiguration,
+	return websiteConfiguration, nil
+}
+
+func flattenS3WebsiteConfiguration(ws *s3.GetBucketWebsiteOutput) (map[string]interface{}, error) {
+	w := make(map[string]interface{})
+
+	if v := ws.IndexDocument; v != nil {
+		w["index_document"] = *v.Suffix
 	}
 
-	log.Printf("[DEBUG] S3 put bucket website: %#v", putInput)
+	if v := ws.ErrorDocument; v != nil {
+		w["error_document"] = *v.Key
+	}
 
-	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
-		return s3conn.PutBucketWebsite(putInput)
-	})
-	if err != nil {
-		return fmt.Errorf("Error putting S3 website: %s", err)
+	if v := ws.RedirectAllRequestsTo; v != nil {
+		if v.Protocol == nil {
+			w["redirect_all_requests_to"] = *v.HostName
+		} else {
+			var host string
+			var path string
+			var query string
+			parsedHostName, err := url.Parse(*v.HostName)
+			if err == nil {
+				host = parsedHostName.Host
+				path = parsedHostName.Path
+				query = parsedHostName.RawQuery
+			} else {
+				host = *v.HostName
+				path = ""
+			}
+
+			w["redirect_all_requests_to"] = (&url.URL{
+				Host:     host,
+				Path:     path,
+				Scheme:   *v.Protocol,
+				RawQuery: query,
+			}).String()
+		}
 	}
 
-	return nil
+	if v := ws.RoutingRules; v != nil {
+		rr, err := normalizeRoutingRules(v)
+		if err != nil {
+			return nil, fmt.Errorf("Error while marshaling routing rules: %s", err)
+		}
+		w["routing_rules"] = rr
+	}
+
+	return w, nil
 }
 
 func resourceAwsS3BucketWebsiteDelete(s3conn *s3.S3, d *schema.ResourceData) error {
@@ -1424,36 +1475,6 @@ func resourceAwsS3BucketWebsiteDelete(s3conn *s3.S3, d *schema.ResourceData) err
 	return nil
 }
 
-func websiteEndpoint(s3conn *s3.S3, d *schema.ResourceData) (*S3Website, error) {
-	// If the bucket doesn't have a website configuration, return an empty
-	// endpoint
-	if _, ok := d.GetOk("website"); !ok {
-		return nil, nil
-	}
-
-	bucket := d.Get("bucket").(string)
-
-	// Lookup the region for this bucket
-
-	locationResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
-		return s3conn.GetBucketLocation(
-			&s3.GetBucketLocationInput{
-				Bucket: aws.String(bucket),
-			},
-		)
-	})
-	if err != nil {
-		return nil, err
-	}
-	location := locationResponse.(*s3.GetBucketLocationOutput)
-	var region string
-	if location.LocationConstraint != nil {
-		region = *location.LocationConstraint
-	}
-
-	return WebsiteEndpoint(bucket, region), nil
-}
-
 func bucketDomainName(bucket string) string {
 	return fmt.Sprintf("%s.s3.amazonaws.com", bucket)
 }
@@ -1534,20 +1555,7 @@ func resourceAwsS3BucketVersioningUpdate(s3conn *s3.S3, d *schema.ResourceData)
 	vc := &s3.VersioningConfiguration{}
 
 	if len(v) > 0 {
-		c := v[0].(map[string]interface{})
-
-		if c["enabled"].(bool) {
-			vc.Status = aws.String(s3.BucketVersioningStatusEnabled)
-		} else {
-			vc.Status = aws.String(s3.BucketVersioningStatusSuspended)
-		}
-
-		if c["mfa_delete"].(bool) {
-			vc.MFADelete = aws.String(s3.MFADeleteEnabled)
-		} else {
-			vc.MFADelete = aws.String(s3.MFADeleteDisabled)
-		}
-
+		vc = expandS3VersioningConfiguration(v[0].(map[string]interface{}))
 	} else {
 		vc.Status = aws.String(s3.BucketVersioningStatusSuspended)
 	}
@@ -1568,23 +1576,31 @@ func resourceAwsS3BucketVersioningUpdate(s3conn *s3.S3, d *schema.ResourceData)
 	return nil
 }
 
+func expandS3VersioningConfiguration(c map[string]interface{}) *s3.VersioningConfiguration {
+	vc := &s3.VersioningConfiguration{}
+
+	if c["enabled"].(bool) {
+		vc.Status = aws.String(s3.BucketVersioningStatusEnabled)
+	} else {
+		vc.Status = aws.String(s3.BucketVersioningStatusSuspended)
+	}
+
+	if c["mfa_delete"].(bool) {
+		vc.MFADelete = aws.String(s3.MFADeleteEnabled)
+	} else {
+		vc.MFADelete = aws.String(s3.MFADeleteDisabled)
+	}
+
+	return vc
+}
+
 func resourceAwsS3BucketLoggingUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
 	logging := d.Get("logging").(*schema.Set).List()
 	bucket := d.Get("bucket").(string)
 	loggingStatus := &s3.BucketLoggingStatus{}
 
 	if len(logging) > 0 {
-		c := logging[0].(map[string]interface{})
-
-		loggingEnabled := &s3.LoggingEnabled{}
-		if val, ok := c["target_bucket"]; ok {
-			loggingEnabled.TargetBucket = aws.String(val.(string))
-		}
-		if val, ok := c["target_prefix"]; ok {
-			loggingEnabled.TargetPrefix = aws.String(val.(string))
-		}
-
-		loggingStatus.LoggingEnabled = loggingEnabled
+		loggingStatus.LoggingEnabled = expandS3LoggingEnabled(logging[0].(map[string]interface{}))
 	}
 
 	i := &s3.PutBucketLoggingInput{
@@ -1603,6 +1619,17 @@ func resourceAwsS3BucketLoggingUpdate(s3conn *s3.S3, d *schema.ResourceData) err
 	return nil
 }
 
+func expandS3LoggingEnabled(c map[string]interface{}) *s3.LoggingEnabled {
+	loggingEnabled := &s3.LoggingEnabled{}
+	if val, ok := c["target_bucket"]; ok {
+		loggingEnabled.TargetBucket = aws.String(val.(string))
+	}
+	if val, ok := c["target_prefix"]; ok {
+		loggingEnabled.TargetPrefix = aws.String(val.(string))
+	}
+	return loggingEnabled
+}
+
 func resourceAwsS3BucketAccelerationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 	enableAcceleration := d.Get("acceleration_status").(string)
@@ -1670,9 +1697,26 @@ func resourceAwsS3BucketServerSideEncryptionConfigurationUpdate(s3conn *s3.S3, d
 
 	c := serverSideEncryptionConfiguration[0].(map[string]interface{})
 
-	rc := &s3.ServerSideEncryptionConfiguration{}
+	rc := &s3.ServerSideEncryptionConfiguration{
+		Rules: expandS3ServerSideEncryptionRules(c["rule"].([]interface{})),
+	}
+	i := &s3.PutBucketEncryptionInput{
+		Bucket:                            aws.String(bucket),
+		ServerSideEncryptionConfiguration: rc,
+	}
+	log.Printf("[DEBUG] S3 put bucket replication configuration: %#v", i)
+
+	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		return s3conn.PutBucketEncryption(i)
+	})
+	if err != nil {
+		return fmt.Errorf("error putting S3 server side encryption configuration: %s", err)
+	}
+
+	return nil
+}
 
-	rcRules := c["rule"].([]interface{})
+func expandS3ServerSideEncryptionRules(rcRules []interface{}) []*s3.ServerSideEncryptionRule {
 	var rules []*s3.ServerSideEncryptionRule
 	for _, v := range rcRules {
 		rr := v.(map[string]interface{})
@@ -1691,22 +1735,7 @@ func resourceAwsS3BucketServerSideEncryptionConfigurationUpdate(s3conn *s3.S3, d
 
 		rules = append(rules, rcRule)
 	}
-
-	rc.Rules = rules
-	i := &s3.PutBucketEncryptionInput{
-		Bucket: aws.String(bucket),
-		ServerSideEncryptionConfiguration: rc,
-	}
-	log.Printf("[DEBUG] S3 put bucket replication configuration: %#v", i)
-
-	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
-		return s3conn.PutBucketEncryption(i)
-	})
-	if err != nil {
-		return fmt.Errorf("error putting S3 server side encryption configuration: %s", err)
-	}
-
-	return nil
+	return rules
 }
 
 func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
@@ -1751,7 +1780,24 @@ func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.
 		rc.Role = aws.String(val.(string))
 	}
 
-	rcRules := c["rules"].(*schema.Set).List()
+	rc.Rules = expandS3ReplicationRules(c["rules"].(*schema.Set).List())
+	i := &s3.PutBucketReplicationInput{
+		Bucket:                   aws.String(bucket),
+		ReplicationConfiguration: rc,
+	}
+	log.Printf("[DEBUG] S3 put bucket replication configuration: %#v", i)
+
+	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		return s3conn.PutBucketReplication(i)
+	})
+	if err != nil {
+		return fmt.Errorf("Error putting S3 replication configuration: %s", err)
+	}
+
+	return nil
+}
+
+func expandS3ReplicationRules(rcRules []interface{}) []*s3.ReplicationRule {
 	rules := []*s3.ReplicationRule{}
 	for _, v := range rcRules {
 		rr := v.(map[string]interface{})
@@ -1799,21 +1845,7 @@ func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.
 		rules = append(rules, rcRule)
 	}
 
-	rc.Rules = rules
-	i := &s3.PutBucketReplicationInput{
-		Bucket: aws.String(bucket),
-		ReplicationConfiguration: rc,
-	}
-	log.Printf("[DEBUG] S3 put bucket replication configuration: %#v", i)
-
-	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
-		return s3conn.PutBucketReplication(i)
-	})
-	if err != nil {
-		return fmt.Errorf("Error putting S3 replication configuration: %s", err)
-	}
-
-	return nil
+	return rules
 }
 
 func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
@@ -1838,9 +1870,35 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 		return nil
 	}
 
+	rules, err := expandS3LifecycleRules(lifecycleRules)
+	if err != nil {
+		return err
+	}
+
+	i := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: rules,
+		},
+	}
+
+	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+		if _, err := s3conn.PutBucketLifecycleConfiguration(i); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error putting S3 lifecycle: %s", err)
+	}
+
+	return nil
+}
+
+func expandS3LifecycleRules(lifecycleRules []interface{}) ([]*s3.LifecycleRule, error) {
 	rules := make([]*s3.LifecycleRule, 0, len(lifecycleRules))
 
-	for i, lifecycleRule := range lifecycleRules {
+	for _, lifecycleRule := range lifecycleRules {
 		r := lifecycleRule.(map[string]interface{})
 
 		rule := &s3.LifecycleRule{}
@@ -1880,7 +1938,7 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 		}
 
 		// Expiration
-		expiration := d.Get(fmt.Sprintf("lifecycle_rule.%d.expiration", i)).(*schema.Set).List()
+		expiration := r["expiration"].(*schema.Set).List()
 		if len(expiration) > 0 {
 			e := expiration[0].(map[string]interface{})
 			i := &s3.LifecycleExpiration{}
@@ -1888,7 +1946,7 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 			if val, ok := e["date"].(string); ok && val != "" {
 				t, err := time.Parse(time.RFC3339, fmt.Sprintf("%sT00:00:00Z", val))
 				if err != nil {
-					return fmt.Errorf("Error Parsing AWS S3 Bucket Lifecycle Expiration Date: %s", err.Error())
+					return nil, fmt.Errorf("Error Parsing AWS S3 Bucket Lifecycle Expiration Date: %s", err.Error())
 				}
 				i.Date = aws.Time(t)
 			} else if val, ok := e["days"].(int); ok && val > 0 {
@@ -1900,7 +1958,7 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 		}
 
 		// NoncurrentVersionExpiration
-		nc_expiration := d.Get(fmt.Sprintf("lifecycle_rule.%d.noncurrent_version_expiration", i)).(*schema.Set).List()
+		nc_expiration := r["noncurrent_version_expiration"].(*schema.Set).List()
 		if len(nc_expiration) > 0 {
 			e := nc_expiration[0].(map[string]interface{})
 
@@ -1912,7 +1970,7 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 		}
 
 		// Transitions
-		transitions := d.Get(fmt.Sprintf("lifecycle_rule.%d.transition", i)).(*schema.Set).List()
+		transitions := r["transition"].(*schema.Set).List()
 		if len(transitions) > 0 {
 			rule.Transitions = make([]*s3.Transition, 0, len(transitions))
 			for _, transition := range transitions {
@@ -1921,7 +1979,7 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 				if val, ok := transition["date"].(string); ok && val != "" {
 					t, err := time.Parse(time.RFC3339, fmt.Sprintf("%sT00:00:00Z", val))
 					if err != nil {
-						return fmt.Errorf("Error Parsing AWS S3 Bucket Lifecycle Expiration Date: %s", err.Error())
+						return nil, fmt.Errorf("Error Parsing AWS S3 Bucket Lifecycle Expiration Date: %s", err.Error())
 					}
 					i.Date = aws.Time(t)
 				} else if val, ok := transition["days"].(int); ok && val >= 0 {
@@ -1935,7 +1993,7 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 			}
 		}
 		// NoncurrentVersionTransitions
-		nc_transitions := d.Get(fmt.Sprintf("lifecycle_rule.%d.noncurrent_version_transition", i)).(*schema.Set).List()
+		nc_transitions := r["noncurrent_version_transition"].(*schema.Set).List()
 		if len(nc_transitions) > 0 {
 			rule.NoncurrentVersionTransitions = make([]*s3.NoncurrentVersionTransition, 0, len(nc_transitions))
 			for _, transition := range nc_transitions {
@@ -1955,24 +2013,151 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 		rules = append(rules, rule)
 	}
 
-	i := &s3.PutBucketLifecycleConfigurationInput{
-		Bucket: aws.String(bucket),
-		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
-			Rules: rules,
-		},
+	return rules, nil
+}
+
+func flattenAwsS3BucketVersioning(versioning *s3.GetBucketVersioningOutput) []map[string]interface{} {
+	vcl := make([]map[string]interface{}, 0, 1)
+	vc := make(map[string]interface{})
+	if versioning.Status != nil && *versioning.Status == s3.BucketVersioningStatusEnabled {
+		vc["enabled"] = true
+	} else {
+		vc["enabled"] = false
 	}
 
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		if _, err := s3conn.PutBucketLifecycleConfiguration(i); err != nil {
-			return resource.NonRetryableError(err)
+	if versioning.MFADelete != nil && *versioning.MFADelete == s3.MFADeleteEnabled {
+		vc["mfa_delete"] = true
+	} else {
+		vc["mfa_delete"] = false
+	}
+	vcl = append(vcl, vc)
+	return vcl
+}
+
+func flattenAwsS3BucketLogging(v *s3.LoggingEnabled) []map[string]interface{} {
+	lcl := make([]map[string]interface{}, 0, 1)
+	if v != nil {
+		lc := make(map[string]interface{})
+		if *v.TargetBucket != "" {
+			lc["target_bucket"] = *v.TargetBucket
 		}
-		return nil
-	})
-	if err != nil {
-		return fmt.Errorf("Error putting S3 lifecycle: %s", err)
+		if *v.TargetPrefix != "" {
+			lc["target_prefix"] = *v.TargetPrefix
+		}
+		lcl = append(lcl, lc)
 	}
+	return lcl
+}
 
-	return nil
+func flattenAwsS3BucketLifecycleRules(lifecycleRules []*s3.LifecycleRule) []map[string]interface{} {
+	rules := make([]map[string]interface{}, 0, len(lifecycleRules))
+
+	for _, lifecycleRule := range lifecycleRules {
+		rule := make(map[string]interface{})
+
+		// ID
+		if lifecycleRule.ID != nil && *lifecycleRule.ID != "" {
+			rule["id"] = *lifecycleRule.ID
+		}
+		filter := lifecycleRule.Filter
+		if filter != nil {
+			if filter.And != nil {
+				// Prefix
+				if filter.And.Prefix != nil && *filter.And.Prefix != "" {
+					rule["prefix"] = *filter.And.Prefix
+				}
+				// Tag
+				if len(filter.And.Tags) > 0 {
+					rule["tags"] = tagsToMapS3(filter.And.Tags)
+				}
+			} else {
+				// Prefix
+				if filter.Prefix != nil && *filter.Prefix != "" {
+					rule["prefix"] = *filter.Prefix
+				}
+			}
+		} else {
+			if lifecycleRule.Prefix != nil {
+				rule["prefix"] = *lifecycleRule.Prefix
+			}
+		}
+
+		// Enabled
+		if lifecycleRule.Status != nil {
+			if *lifecycleRule.Status == s3.ExpirationStatusEnabled {
+				rule["enabled"] = true
+			} else {
+				rule["enabled"] = false
+			}
+		}
+
+		// AbortIncompleteMultipartUploadDays
+		if lifecycleRule.AbortIncompleteMultipartUpload != nil {
+			if lifecycleRule.AbortIncompleteMultipartUpload.DaysAfterInitiation != nil {
+				rule["abort_incomplete_multipart_upload_days"] = int(*lifecycleRule.AbortIncompleteMultipartUpload.DaysAfterInitiation)
+			}
+		}
+
+		// expiration
+		if lifecycleRule.Expiration != nil {
+			e := make(map[string]interface{})
+			if lifecycleRule.Expiration.Date != nil {
+				e["date"] = (*lifecycleRule.Expiration.Date).Format("2006-01-02")
+			}
+			if lifecycleRule.Expiration.Days != nil {
+				e["days"] = int(*lifecycleRule.Expiration.Days)
+			}
+			if lifecycleRule.Expiration.ExpiredObjectDeleteMarker != nil {
+				e["expired_object_delete_marker"] = *lifecycleRule.Expiration.ExpiredObjectDeleteMarker
+			}
+			rule["expiration"] = schema.NewSet(expirationHash, []interface{}{e})
+		}
+		// noncurrent_version_expiration
+		if lifecycleRule.NoncurrentVersionExpiration != nil {
+			e := make(map[string]interface{})
+			if lifecycleRule.NoncurrentVersionExpiration.NoncurrentDays != nil {
+				e["days"] = int(*lifecycleRule.NoncurrentVersionExpiration.NoncurrentDays)
+			}
+			rule["noncurrent_version_expiration"] = schema.NewSet(expirationHash, []interface{}{e})
+		}
+		//// transition
+		if len(lifecycleRule.Transitions) > 0 {
+			transitions := make([]interface{}, 0, len(lifecycleRule.Transitions))
+			for _, v := range lifecycleRule.Transitions {
+				t := make(map[string]interface{})
+				if v.Date != nil {
+					t["date"] = (*v.Date).Format("2006-01-02")
+				}
+				if v.Days != nil {
+					t["days"] = int(*v.Days)
+				}
+				if v.StorageClass != nil {
+					t["storage_class"] = *v.StorageClass
+				}
+				transitions = append(transitions, t)
+			}
+			rule["transition"] = schema.NewSet(transitionHash, transitions)
+		}
+		// noncurrent_version_transition
+		if len(lifecycleRule.NoncurrentVersionTransitions) > 0 {
+			transitions := make([]interface{}, 0, len(lifecycleRule.NoncurrentVersionTransitions))
+			for _, v := range lifecycleRule.NoncurrentVersionTransitions {
+				t := make(map[string]interface{})
+				if v.NoncurrentDays != nil {
+					t["days"] = int(*v.NoncurrentDays)
+				}
+				if v.StorageClass != nil {
+					t["storage_class"] = *v.StorageClass
+				}
+				transitions = append(transitions, t)
+			}
+			rule["noncurrent_version_transition"] = schema.NewSet(transitionHash, transitions)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
 }
 
 func flattenAwsS3ServerSideEncryptionConfiguration(c *s3.ServerSideEncryptionConfiguration) []map[string]interface{} {
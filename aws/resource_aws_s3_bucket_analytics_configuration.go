@@ -0,0 +1,391 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsS3BucketAnalyticsConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketAnalyticsConfigurationPut,
+		Read:   resourceAwsS3BucketAnalyticsConfigurationRead,
+		Update: resourceAwsS3BucketAnalyticsConfigurationPut,
+		Delete: resourceAwsS3BucketAnalyticsConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"tags": tagsSchema(),
+					},
+				},
+			},
+			"storage_class_analysis": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_export": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"output_schema_version": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  s3.StorageClassAnalysisSchemaVersionV1,
+										ValidateFunc: validation.StringInSlice([]string{
+											s3.StorageClassAnalysisSchemaVersionV1,
+										}, false),
+									},
+									"destination": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"s3_bucket_destination": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"bucket_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validateArn,
+															},
+															"bucket_account_id": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+															"format": {
+																Type:     schema.TypeString,
+																Optional: true,
+																Default:  s3.AnalyticsS3ExportFileFormatCsv,
+																ValidateFunc: validation.StringInSlice([]string{
+																	s3.AnalyticsS3ExportFileFormatCsv,
+																}, false),
+															},
+															"prefix": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketAnalyticsConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+	name := d.Get("name").(string)
+
+	analyticsConfiguration := &s3.AnalyticsConfiguration{
+		Id:                   aws.String(name),
+		StorageClassAnalysis: &s3.StorageClassAnalysis{},
+	}
+
+	if v, ok := d.GetOk("filter"); ok {
+		filterList := v.([]interface{})
+		if filterMap, ok := filterList[0].(map[string]interface{}); ok {
+			analyticsConfiguration.Filter = expandS3AnalyticsFilter(filterMap)
+		}
+	}
+
+	if v, ok := d.GetOk("storage_class_analysis"); ok {
+		analyticsConfiguration.StorageClassAnalysis = expandS3StorageClassAnalysis(v.([]interface{}))
+	}
+
+	input := &s3.PutBucketAnalyticsConfigurationInput{
+		Bucket:                 aws.String(bucket),
+		Id:                     aws.String(name),
+		AnalyticsConfiguration: analyticsConfiguration,
+	}
+
+	log.Printf("[DEBUG] Putting S3 bucket analytics configuration: %s", input)
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.PutBucketAnalyticsConfiguration(input)
+		if err != nil {
+			if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error putting S3 bucket analytics configuration: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", bucket, name))
+
+	return resourceAwsS3BucketAnalyticsConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketAnalyticsConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket, name, err := resourceAwsS3BucketAnalyticsConfigurationParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &s3.DeleteBucketAnalyticsConfigurationInput{
+		Bucket: aws.String(bucket),
+		Id:     aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Deleting S3 bucket analytics configuration: %s", input)
+	_, err = conn.DeleteBucketAnalyticsConfiguration(input)
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchConfiguration", "The specified configuration does not exist.") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting S3 bucket analytics configuration: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketAnalyticsConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket, name, err := resourceAwsS3BucketAnalyticsConfigurationParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("bucket", bucket)
+	d.Set("name", name)
+
+	input := &s3.GetBucketAnalyticsConfigurationInput{
+		Bucket: aws.String(bucket),
+		Id:     aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Reading S3 bucket analytics configuration: %s", input)
+	output, err := conn.GetBucketAnalyticsConfiguration(input)
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchConfiguration", "The specified configuration does not exist.") {
+			log.Printf("[WARN] %s S3 bucket analytics configuration not found, removing from state.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if output.AnalyticsConfiguration == nil {
+		return fmt.Errorf("Error reading S3 bucket analytics configuration (%s): empty response", d.Id())
+	}
+
+	if err := d.Set("filter", flattenS3AnalyticsFilter(output.AnalyticsConfiguration.Filter)); err != nil {
+		return fmt.Errorf("error setting filter: %s", err)
+	}
+
+	if err := d.Set("storage_class_analysis", flattenS3StorageClassAnalysis(output.AnalyticsConfiguration.StorageClassAnalysis)); err != nil {
+		return fmt.Errorf("error setting storage_class_analysis: %s", err)
+	}
+
+	return nil
+}
+
+func expandS3AnalyticsFilter(m map[string]interface{}) *s3.AnalyticsFilter {
+	var prefix string
+	if v, ok := m["prefix"]; ok {
+		prefix = v.(string)
+	}
+
+	var tags []*s3.Tag
+	if v, ok := m["tags"]; ok {
+		tags = tagsFromMapS3(v.(map[string]interface{}))
+	}
+
+	if prefix == "" && len(tags) == 0 {
+		return nil
+	}
+
+	analyticsFilter := &s3.AnalyticsFilter{}
+	if prefix != "" && len(tags) > 0 {
+		analyticsFilter.And = &s3.AnalyticsAndOperator{
+			Prefix: aws.String(prefix),
+			Tags:   tags,
+		}
+	} else if len(tags) > 1 {
+		analyticsFilter.And = &s3.AnalyticsAndOperator{
+			Tags: tags,
+		}
+	} else if len(tags) == 1 {
+		analyticsFilter.Tag = tags[0]
+	} else {
+		analyticsFilter.Prefix = aws.String(prefix)
+	}
+	return analyticsFilter
+}
+
+func flattenS3AnalyticsFilter(analyticsFilter *s3.AnalyticsFilter) []map[string]interface{} {
+	if analyticsFilter == nil {
+		return nil
+	}
+
+	m := make(map[string]interface{})
+
+	if analyticsFilter.And != nil {
+		and := *analyticsFilter.And
+		if and.Prefix != nil {
+			m["prefix"] = *and.Prefix
+		}
+		if and.Tags != nil {
+			m["tags"] = tagsToMapS3(and.Tags)
+		}
+	} else if analyticsFilter.Prefix != nil {
+		m["prefix"] = *analyticsFilter.Prefix
+	} else if analyticsFilter.Tag != nil {
+		tags := []*s3.Tag{
+			analyticsFilter.Tag,
+		}
+		m["tags"] = tagsToMapS3(tags)
+	} else {
+		return nil
+	}
+	return []map[string]interface{}{m}
+}
+
+func expandS3StorageClassAnalysis(l []interface{}) *s3.StorageClassAnalysis {
+	result := &s3.StorageClassAnalysis{}
+
+	if len(l) == 0 || l[0] == nil {
+		return result
+	}
+
+	m := l[0].(map[string]interface{})
+	dataExportList, ok := m["data_export"].([]interface{})
+	if !ok || len(dataExportList) == 0 || dataExportList[0] == nil {
+		return result
+	}
+	dataExportMap := dataExportList[0].(map[string]interface{})
+
+	dataExport := &s3.StorageClassAnalysisDataExport{}
+	result.DataExport = dataExport
+
+	if v, ok := dataExportMap["output_schema_version"]; ok {
+		dataExport.OutputSchemaVersion = aws.String(v.(string))
+	}
+
+	destinationList, ok := dataExportMap["destination"].([]interface{})
+	if !ok || len(destinationList) == 0 || destinationList[0] == nil {
+		return result
+	}
+	destinationMap := destinationList[0].(map[string]interface{})
+
+	bucketDestinationList, ok := destinationMap["s3_bucket_destination"].([]interface{})
+	if !ok || len(bucketDestinationList) == 0 || bucketDestinationList[0] == nil {
+		return result
+	}
+	bucketDestinationMap := bucketDestinationList[0].(map[string]interface{})
+
+	bucketDestination := &s3.AnalyticsS3BucketDestination{
+		Bucket: aws.String(bucketDestinationMap["bucket_arn"].(string)),
+		Format: aws.String(bucketDestinationMap["format"].(string)),
+	}
+	if v, ok := bucketDestinationMap["bucket_account_id"]; ok && v.(string) != "" {
+		bucketDestination.BucketAccountId = aws.String(v.(string))
+	}
+	if v, ok := bucketDestinationMap["prefix"]; ok && v.(string) != "" {
+		bucketDestination.Prefix = aws.String(v.(string))
+	}
+
+	dataExport.Destination = &s3.AnalyticsExportDestination{
+		S3BucketDestination: bucketDestination,
+	}
+
+	return result
+}
+
+func flattenS3StorageClassAnalysis(storageClassAnalysis *s3.StorageClassAnalysis) []map[string]interface{} {
+	if storageClassAnalysis == nil || storageClassAnalysis.DataExport == nil {
+		return nil
+	}
+
+	dataExport := storageClassAnalysis.DataExport
+	mDataExport := map[string]interface{}{
+		"output_schema_version": aws.StringValue(dataExport.OutputSchemaVersion),
+	}
+
+	if dataExport.Destination != nil && dataExport.Destination.S3BucketDestination != nil {
+		bucketDestination := dataExport.Destination.S3BucketDestination
+		mBucketDestination := map[string]interface{}{
+			"bucket_arn": aws.StringValue(bucketDestination.Bucket),
+			"format":     aws.StringValue(bucketDestination.Format),
+		}
+		if bucketDestination.BucketAccountId != nil {
+			mBucketDestination["bucket_account_id"] = aws.StringValue(bucketDestination.BucketAccountId)
+		}
+		if bucketDestination.Prefix != nil {
+			mBucketDestination["prefix"] = aws.StringValue(bucketDestination.Prefix)
+		}
+
+		mDataExport["destination"] = []interface{}{
+			map[string]interface{}{
+				"s3_bucket_destination": []interface{}{mBucketDestination},
+			},
+		}
+	}
+
+	return []map[string]interface{}{
+		{
+			"data_export": []interface{}{mDataExport},
+		},
+	}
+}
+
+func resourceAwsS3BucketAnalyticsConfigurationParseID(id string) (string, string, error) {
+	idParts := strings.Split(id, ":")
+	if len(idParts) != 2 {
+		return "", "", fmt.Errorf("please make sure the ID is in the form BUCKET:NAME (i.e. my-bucket:EntireBucket")
+	}
+	bucket := idParts[0]
+	name := idParts[1]
+	return bucket, name, nil
+}
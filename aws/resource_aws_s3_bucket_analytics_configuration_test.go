@@ -0,0 +1,326 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+func TestExpandS3AnalyticsFilter(t *testing.T) {
+	testCases := []struct {
+		Config                    map[string]interface{}
+		ExpectedS3AnalyticsFilter *s3.AnalyticsFilter
+	}{
+		{
+			Config:                    map[string]interface{}{},
+			ExpectedS3AnalyticsFilter: nil,
+		},
+		{
+			Config: map[string]interface{}{
+				"prefix": "prefix/",
+			},
+			ExpectedS3AnalyticsFilter: &s3.AnalyticsFilter{
+				Prefix: aws.String("prefix/"),
+			},
+		},
+		{
+			Config: map[string]interface{}{
+				"prefix": "prefix/",
+				"tags": map[string]interface{}{
+					"tag1key": "tag1value",
+				},
+			},
+			ExpectedS3AnalyticsFilter: &s3.AnalyticsFilter{
+				And: &s3.AnalyticsAndOperator{
+					Prefix: aws.String("prefix/"),
+					Tags: []*s3.Tag{
+						{
+							Key:   aws.String("tag1key"),
+							Value: aws.String("tag1value"),
+						},
+					},
+				},
+			},
+		},
+		{
+			Config: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"tag1key": "tag1value",
+				},
+			},
+			ExpectedS3AnalyticsFilter: &s3.AnalyticsFilter{
+				Tag: &s3.Tag{
+					Key:   aws.String("tag1key"),
+					Value: aws.String("tag1value"),
+				},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		value := expandS3AnalyticsFilter(tc.Config)
+
+		if !reflect.DeepEqual(value, tc.ExpectedS3AnalyticsFilter) {
+			t.Fatalf("Case #%d: Given:\n%s\n\nExpected:\n%s", i, value, tc.ExpectedS3AnalyticsFilter)
+		}
+	}
+}
+
+func TestFlattenS3StorageClassAnalysis(t *testing.T) {
+	testCases := []struct {
+		StorageClassAnalysis *s3.StorageClassAnalysis
+		Expected             []map[string]interface{}
+	}{
+		{
+			StorageClassAnalysis: nil,
+			Expected:             nil,
+		},
+		{
+			StorageClassAnalysis: &s3.StorageClassAnalysis{},
+			Expected:             nil,
+		},
+		{
+			StorageClassAnalysis: &s3.StorageClassAnalysis{
+				DataExport: &s3.StorageClassAnalysisDataExport{
+					OutputSchemaVersion: aws.String(s3.StorageClassAnalysisSchemaVersionV1),
+					Destination: &s3.AnalyticsExportDestination{
+						S3BucketDestination: &s3.AnalyticsS3BucketDestination{
+							Bucket: aws.String("arn:aws:s3:::destination"),
+							Format: aws.String(s3.AnalyticsS3ExportFileFormatCsv),
+							Prefix: aws.String("prefix/"),
+						},
+					},
+				},
+			},
+			Expected: []map[string]interface{}{
+				{
+					"data_export": []interface{}{
+						map[string]interface{}{
+							"output_schema_version": s3.StorageClassAnalysisSchemaVersionV1,
+							"destination": []interface{}{
+								map[string]interface{}{
+									"s3_bucket_destination": []interface{}{
+										map[string]interface{}{
+											"bucket_arn": "arn:aws:s3:::destination",
+											"format":     s3.AnalyticsS3ExportFileFormatCsv,
+											"prefix":     "prefix/",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		value := flattenS3StorageClassAnalysis(tc.StorageClassAnalysis)
+
+		if !reflect.DeepEqual(value, tc.Expected) {
+			t.Fatalf("Case #%d: Given:\n%#v\n\nExpected:\n%#v", i, value, tc.Expected)
+		}
+	}
+}
+
+func TestAccAWSS3BucketAnalyticsConfiguration_basic(t *testing.T) {
+	var conf s3.AnalyticsConfiguration
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_analytics_configuration.test"
+
+	bucketName := fmt.Sprintf("tf-acc-%d", rInt)
+	analyticsName := t.Name()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketAnalyticsConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketAnalyticsConfigurationConfig(bucketName, analyticsName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "bucket", bucketName),
+					resource.TestCheckResourceAttr(resourceName, "name", analyticsName),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketAnalyticsConfiguration_withFilterAndDataExport(t *testing.T) {
+	var conf s3.AnalyticsConfiguration
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_analytics_configuration.test"
+
+	bucketName := fmt.Sprintf("tf-acc-%d", rInt)
+	analyticsName := t.Name()
+	prefix := fmt.Sprintf("prefix-%d/", rInt)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketAnalyticsConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketAnalyticsConfigurationConfigWithFilterAndDataExport(bucketName, analyticsName, prefix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", prefix),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.output_schema_version", "V_1"),
+					resource.TestMatchResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.0.bucket_arn", regexp.MustCompile("^arn:aws:s3:::"+bucketName+"-destination$")),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.0.format", "CSV"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.0.prefix", "analytics"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketAnalyticsConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_analytics_configuration" {
+			continue
+		}
+
+		bucket, name, err := resourceAwsS3BucketAnalyticsConfigurationParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+			input := &s3.GetBucketAnalyticsConfigurationInput{
+				Bucket: aws.String(bucket),
+				Id:     aws.String(name),
+			}
+			log.Printf("[DEBUG] Reading S3 bucket analytics configuration: %s", input)
+			output, err := conn.GetBucketAnalyticsConfiguration(input)
+			if err != nil {
+				if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchConfiguration", "The specified configuration does not exist.") {
+					return nil
+				}
+				return resource.NonRetryableError(err)
+			}
+			if output.AnalyticsConfiguration != nil {
+				return resource.RetryableError(fmt.Errorf("S3 bucket analytics configuration exists: %v", output))
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccCheckAWSS3BucketAnalyticsConfigurationExists(n string, res *s3.AnalyticsConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 bucket analytics configuration ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+		bucket, name, err := resourceAwsS3BucketAnalyticsConfigurationParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		input := &s3.GetBucketAnalyticsConfigurationInput{
+			Bucket: aws.String(bucket),
+			Id:     aws.String(name),
+		}
+		log.Printf("[DEBUG] Reading S3 bucket analytics configuration: %s", input)
+		output, err := conn.GetBucketAnalyticsConfiguration(input)
+		if err != nil {
+			return err
+		}
+
+		*res = *output.AnalyticsConfiguration
+
+		return nil
+	}
+}
+
+func testAccAWSS3BucketAnalyticsConfigurationConfig(bucketName, analyticsName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+  bucket = "%s"
+}
+
+resource "aws_s3_bucket_analytics_configuration" "test" {
+  bucket = "${aws_s3_bucket.bucket.id}"
+  name   = "%s"
+}
+`, bucketName, analyticsName)
+}
+
+func testAccAWSS3BucketAnalyticsConfigurationConfigWithFilterAndDataExport(bucketName, analyticsName, prefix string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+  bucket = "%s"
+}
+
+resource "aws_s3_bucket" "destination" {
+  bucket = "%s-destination"
+}
+
+resource "aws_s3_bucket_analytics_configuration" "test" {
+  bucket = "${aws_s3_bucket.bucket.id}"
+  name   = "%s"
+
+  filter {
+    prefix = "%s"
+
+    tags {
+      class = "blue"
+    }
+  }
+
+  storage_class_analysis {
+    data_export {
+      destination {
+        s3_bucket_destination {
+          bucket_arn = "${aws_s3_bucket.destination.arn}"
+          prefix     = "analytics"
+        }
+      }
+    }
+  }
+}
+`, bucketName, bucketName, analyticsName, prefix)
+}
@@ -0,0 +1,432 @@
+package aws
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/mitchellh/go-homedir"
+)
+
+// The maximum number of keys accepted by a single DeleteObjects request.
+const s3DeleteObjectsBatchSize = 1000
+
+func resourceAwsS3BucketDirectorySync() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketDirectorySyncPut,
+		Read:   resourceAwsS3BucketDirectorySyncRead,
+		Update: resourceAwsS3BucketDirectorySyncPut,
+		Delete: resourceAwsS3BucketDirectorySyncDelete,
+
+		CustomizeDiff: resourceAwsS3BucketDirectorySyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+
+			"source": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"acl": {
+				Type:     schema.TypeString,
+				Default:  "private",
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ObjectCannedACLPrivate,
+					s3.ObjectCannedACLPublicRead,
+					s3.ObjectCannedACLPublicReadWrite,
+					s3.ObjectCannedACLAuthenticatedRead,
+					s3.ObjectCannedACLAwsExecRead,
+					s3.ObjectCannedACLBucketOwnerRead,
+					s3.ObjectCannedACLBucketOwnerFullControl,
+				}, false),
+			},
+
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"storage_class": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.StorageClassStandard,
+					s3.StorageClassReducedRedundancy,
+					s3.StorageClassOnezoneIa,
+					s3.StorageClassStandardIa,
+				}, false),
+			},
+
+			"server_side_encryption": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ServerSideEncryptionAes256,
+					s3.ServerSideEncryptionAwsKms,
+				}, false),
+			},
+
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"files": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketDirectorySyncCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("source") || !diff.NewValueKnown("prefix") {
+		return diff.SetNewComputed("files")
+	}
+
+	files, err := s3DirectorySyncLocalFiles(diff.Get("source").(string), strings.Trim(diff.Get("prefix").(string), "/"))
+	if err != nil {
+		return err
+	}
+
+	old := diff.Get("files").(map[string]interface{})
+	if len(old) != len(files) {
+		return diff.SetNew("files", files)
+	}
+	for k, v := range files {
+		if old[k] != v {
+			return diff.SetNew("files", files)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketDirectorySyncPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+	prefix := strings.Trim(d.Get("prefix").(string), "/")
+	source := d.Get("source").(string)
+
+	root, err := homedir.Expand(source)
+	if err != nil {
+		return fmt.Errorf("Error expanding homedir in source (%s): %s", source, err)
+	}
+
+	files, err := s3DirectorySyncLocalFiles(source, prefix)
+	if err != nil {
+		return err
+	}
+
+	// Every object has to be uploaded again when its settings change.
+	uploadAll := d.HasChange("acl") || d.HasChange("cache_control") || d.HasChange("storage_class") ||
+		d.HasChange("server_side_encryption") || d.HasChange("kms_key_id")
+
+	o, _ := d.GetChange("files")
+	old := o.(map[string]interface{})
+
+	// The planned hashes must only reach the state for the objects that were
+	// actually synced, otherwise a failed upload or delete is never retried.
+	newResource := d.Id() == ""
+	d.Partial(true)
+	d.SetId(fmt.Sprintf("%s:%s", bucket, prefix))
+
+	var synced []string
+	partialFailure := func(err error) error {
+		if err := d.Set("files", s3DirectorySyncMergeFiles(old, files, synced)); err != nil {
+			log.Printf("[WARN] Error setting files: %s", err)
+		}
+		d.SetPartial("files")
+		if newResource {
+			d.SetPartial("bucket")
+			d.SetPartial("prefix")
+		}
+		return err
+	}
+
+	uploader := s3manager.NewUploaderWithClient(conn)
+	for key, hash := range files {
+		if !uploadAll && old[key] == hash {
+			continue
+		}
+
+		rel := strings.TrimPrefix(strings.TrimPrefix(key, prefix), "/")
+		if err := s3DirectorySyncUploadFile(d, uploader, bucket, key, filepath.Join(root, filepath.FromSlash(rel))); err != nil {
+			return partialFailure(err)
+		}
+		synced = append(synced, key)
+	}
+
+	var removed []string
+	for key := range old {
+		if _, ok := files[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	// Keys that may not have been deleted stay in state; Read drops the ones
+	// that are already gone from the bucket.
+	if err := s3DirectorySyncDeleteObjects(conn, bucket, removed); err != nil {
+		return partialFailure(err)
+	}
+
+	d.Partial(false)
+
+	if err := d.Set("files", files); err != nil {
+		return fmt.Errorf("error setting files: %s", err)
+	}
+
+	return resourceAwsS3BucketDirectorySyncRead(d, meta)
+}
+
+func resourceAwsS3BucketDirectorySyncRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+	prefix := strings.Trim(d.Get("prefix").(string), "/")
+
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix + "/")
+	}
+
+	remote := make(map[string]bool)
+	log.Printf("[DEBUG] Listing S3 bucket objects: %s", input)
+	err := conn.ListObjectsV2Pages(input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			remote[aws.StringValue(object.Key)] = true
+		}
+		return !lastPage
+	})
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+			log.Printf("[WARN] S3 bucket (%s) not found, removing directory sync from state", bucket)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error listing S3 bucket (%s) objects: %s", bucket, err)
+	}
+
+	// Forget objects removed outside of Terraform so they are uploaded again.
+	files := make(map[string]interface{})
+	for key, hash := range d.Get("files").(map[string]interface{}) {
+		if remote[key] {
+			files[key] = hash
+		} else {
+			log.Printf("[WARN] S3 object (%s) not found in bucket (%s)", key, bucket)
+		}
+	}
+
+	if err := d.Set("files", files); err != nil {
+		return fmt.Errorf("error setting files: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketDirectorySyncDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+
+	var keys []string
+	for key := range d.Get("files").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+
+	return s3DirectorySyncDeleteObjects(conn, bucket, keys)
+}
+
+// s3DirectorySyncLocalFiles walks the source directory and returns a map of
+// object key to the SHA-256 of the file content.
+func s3DirectorySyncLocalFiles(source, prefix string) (map[string]interface{}, error) {
+	root, err := homedir.Expand(source)
+	if err != nil {
+		return nil, fmt.Errorf("Error expanding homedir in source (%s): %s", source, err)
+	}
+
+	files := make(map[string]interface{})
+	err = filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+
+		file, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		hash, err := s3BucketObjectContentSha256(file)
+		if err != nil {
+			return err
+		}
+
+		files[path.Join(prefix, filepath.ToSlash(rel))] = hash
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading S3 directory sync source (%s): %s", source, err)
+	}
+
+	return files, nil
+}
+
+// s3DirectorySyncMergeFiles returns the file hashes previously in state
+// updated with the new hashes of the synced keys. Synced keys missing from
+// the new files were deleted from the bucket.
+func s3DirectorySyncMergeFiles(old, files map[string]interface{}, synced []string) map[string]interface{} {
+	merged := make(map[string]interface{}, len(old))
+	for k, v := range old {
+		merged[k] = v
+	}
+
+	for _, k := range synced {
+		if v, ok := files[k]; ok {
+			merged[k] = v
+		} else {
+			delete(merged, k)
+		}
+	}
+
+	return merged
+}
+
+// s3DirectorySyncContentType detects the content type of a file from its
+// extension, falling back to sniffing its content.
+func s3DirectorySyncContentType(name string, file io.ReadSeeker) (string, error) {
+	if contentType := mime.TypeByExtension(filepath.Ext(name)); contentType != "" {
+		return contentType, nil
+	}
+
+	buf := make([]byte, 512)
+	n, err := file.Read(buf)
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return http.DetectContentType(buf[:n]), nil
+}
+
+func s3DirectorySyncUploadFile(d *schema.ResourceData, uploader *s3manager.Uploader, bucket, key, name string) error {
+	file, err := os.Open(name)
+	if err != nil {
+		return fmt.Errorf("Error opening S3 directory sync file (%s): %s", name, err)
+	}
+	defer file.Close()
+
+	contentType, err := s3DirectorySyncContentType(name, file)
+	if err != nil {
+		return fmt.Errorf("error detecting content type of %s: %s", name, err)
+	}
+
+	input := &s3manager.UploadInput{
+		Bucket:      aws.String(bucket),
+		Key:         aws.String(key),
+		ACL:         aws.String(d.Get("acl").(string)),
+		ContentType: aws.String(contentType),
+		Body:        file,
+	}
+
+	if v, ok := d.GetOk("cache_control"); ok {
+		input.CacheControl = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("storage_class"); ok {
+		input.StorageClass = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		input.ServerSideEncryption = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.SSEKMSKeyId = aws.String(v.(string))
+		input.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
+	}
+
+	log.Printf("[DEBUG] Uploading S3 object (%s) to bucket (%s)", key, bucket)
+	if _, err := uploader.Upload(input); err != nil {
+		return fmt.Errorf("Error putting object (%s) in S3 bucket (%s): %s", key, bucket, err)
+	}
+
+	return nil
+}
+
+func s3DirectorySyncDeleteObjects(conn *s3.S3, bucket string, keys []string) error {
+	sort.Strings(keys)
+
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > s3DeleteObjectsBatchSize {
+			n = s3DeleteObjectsBatchSize
+		}
+
+		objects := make([]*s3.ObjectIdentifier, 0, n)
+		for _, key := range keys[:n] {
+			objects = append(objects, &s3.ObjectIdentifier{Key: aws.String(key)})
+		}
+		keys = keys[n:]
+
+		input := &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		}
+
+		log.Printf("[DEBUG] Deleting %d S3 objects from bucket (%s)", len(objects), bucket)
+		output, err := conn.DeleteObjects(input)
+		if err != nil {
+			if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+				return nil
+			}
+			return fmt.Errorf("error deleting S3 objects from bucket (%s): %s", bucket, err)
+		}
+		if len(output.Errors) > 0 {
+			e := output.Errors[0]
+			return fmt.Errorf("error deleting S3 object (%s) from bucket (%s): %s", aws.StringValue(e.Key), bucket, aws.StringValue(e.Message))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,245 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestS3DirectorySyncLocalFiles(t *testing.T) {
+	dir := testAccAWSS3BucketDirectorySyncSource(t)
+	defer os.RemoveAll(dir)
+
+	files, err := s3DirectorySyncLocalFiles(dir, "site")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]interface{}{
+		"site/index.html":   "03177744232ae062e284caf9ef78e6b5816c6e64786a6d010879e4d1c70d30c1",
+		"site/css/site.css": "62368a1a29259b30bac235c0e75dc700c9b3bacf1513ad5708e4fe4a6c0d6560",
+	}
+	if !reflect.DeepEqual(files, expected) {
+		t.Fatalf("Expected files:\n%#v\n\nGot:\n%#v", expected, files)
+	}
+}
+
+func TestS3DirectorySyncContentType(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Content  string
+		Expected string
+	}{
+		{
+			Name:     "index.html",
+			Content:  "<html></html>",
+			Expected: "text/html; charset=utf-8",
+		},
+		{
+			Name:     "style.css",
+			Content:  "body {}",
+			Expected: "text/css; charset=utf-8",
+		},
+		{
+			Name:     "LICENSE",
+			Content:  "plain text",
+			Expected: "text/plain; charset=utf-8",
+		},
+	}
+
+	for i, tc := range testCases {
+		body := bytes.NewReader([]byte(tc.Content))
+		contentType, err := s3DirectorySyncContentType(tc.Name, body)
+		if err != nil {
+			t.Fatalf("Case #%d: %s", i, err)
+		}
+		if contentType != tc.Expected {
+			t.Fatalf("Case #%d: Expected content type %q, got %q", i, tc.Expected, contentType)
+		}
+		if body.Len() != len(tc.Content) {
+			t.Fatalf("Case #%d: Expected body to be rewound", i)
+		}
+	}
+}
+
+func TestS3DirectorySyncMergeFiles(t *testing.T) {
+	old := map[string]interface{}{
+		"index.html": "old-index",
+		"error.html": "old-error",
+		"about.html": "old-about",
+	}
+	files := map[string]interface{}{
+		"index.html": "new-index",
+		"error.html": "new-error",
+		"site.css":   "new-css",
+	}
+
+	testCases := []struct {
+		Synced   []string
+		Expected map[string]interface{}
+	}{
+		{
+			Synced: nil,
+			Expected: map[string]interface{}{
+				"index.html": "old-index",
+				"error.html": "old-error",
+				"about.html": "old-about",
+			},
+		},
+		{
+			Synced: []string{"index.html", "site.css"},
+			Expected: map[string]interface{}{
+				"index.html": "new-index",
+				"error.html": "old-error",
+				"about.html": "old-about",
+				"site.css":   "new-css",
+			},
+		},
+		{
+			Synced: []string{"index.html", "error.html", "site.css", "about.html"},
+			Expected: map[string]interface{}{
+				"index.html": "new-index",
+				"error.html": "new-error",
+				"site.css":   "new-css",
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		merged := s3DirectorySyncMergeFiles(old, files, tc.Synced)
+		if !reflect.DeepEqual(merged, tc.Expected) {
+			t.Fatalf("Case #%d: Expected files:\n%#v\n\nGot:\n%#v", i, tc.Expected, merged)
+		}
+	}
+
+	if len(old) != 3 || old["index.html"] != "old-index" {
+		t.Fatalf("Expected old files to be left unchanged, got: %#v", old)
+	}
+}
+
+func TestAccAWSS3BucketDirectorySync_basic(t *testing.T) {
+	dir := testAccAWSS3BucketDirectorySyncSource(t)
+	defer os.RemoveAll(dir)
+
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_directory_sync.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketDirectorySyncConfig(rInt, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					testAccCheckAWSS3BucketDirectorySyncObject(resourceName, "site/index.html", "text/html; charset=utf-8"),
+					testAccCheckAWSS3BucketDirectorySyncObject(resourceName, "site/css/site.css", "text/css; charset=utf-8"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.Remove(filepath.Join(dir, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+					if err := ioutil.WriteFile(filepath.Join(dir, "error.html"), []byte("<html>error</html>"), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccAWSS3BucketDirectorySyncConfig(rInt, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					testAccCheckAWSS3BucketDirectorySyncObject(resourceName, "site/error.html", "text/html; charset=utf-8"),
+					testAccCheckAWSS3BucketDirectorySyncObjectDeleted(resourceName, "site/css/site.css"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketDirectorySyncObject(n, key, contentType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+		output, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			return fmt.Errorf("S3 object (%s) error: %s", key, err)
+		}
+
+		if v := aws.StringValue(output.ContentType); v != contentType {
+			return fmt.Errorf("Expected S3 object (%s) content type %q, got %q", key, contentType, v)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSS3BucketDirectorySyncObjectDeleted(n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+		_, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+		if err == nil {
+			return fmt.Errorf("S3 object (%s) still exists", key)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSS3BucketDirectorySyncSource(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "tf-acc-s3-directory-sync")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Mkdir(filepath.Join(dir, "css"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "index.html"), []byte("<html>index</html>"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "css", "site.css"), []byte("body {}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	return dir
+}
+
+func testAccAWSS3BucketDirectorySyncConfig(randInt int, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+  bucket = "tf-test-bucket-%d"
+}
+
+resource "aws_s3_bucket_directory_sync" "test" {
+  bucket = "${aws_s3_bucket.bucket.id}"
+  prefix = "site"
+  source = "%s"
+}
+`, randInt, source)
+}
@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsS3BucketLifecycleConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketLifecycleConfigurationPut,
+		Read:   resourceAwsS3BucketLifecycleConfigurationRead,
+		Update: resourceAwsS3BucketLifecycleConfigurationPut,
+		Delete: resourceAwsS3BucketLifecycleConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     resourceAwsS3BucketLifecycleRule(),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketLifecycleConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+
+	rules, err := expandS3LifecycleRules(d.Get("rule").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	input := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: rules,
+		},
+	}
+
+	log.Printf("[DEBUG] Putting S3 bucket lifecycle configuration: %s", input)
+	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.PutBucketLifecycleConfiguration(input)
+		if err != nil {
+			if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error putting S3 bucket lifecycle configuration: %s", err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketLifecycleConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketLifecycleConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.GetBucketLifecycleConfigurationInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading S3 bucket lifecycle configuration: %s", input)
+	output, err := conn.GetBucketLifecycleConfiguration(input)
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchLifecycleConfiguration", "") {
+			log.Printf("[WARN] S3 bucket lifecycle configuration (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading S3 bucket lifecycle configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+	if err := d.Set("rule", flattenAwsS3BucketLifecycleRules(output.Rules)); err != nil {
+		return fmt.Errorf("error setting rule: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketLifecycleConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.DeleteBucketLifecycleInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting S3 bucket lifecycle configuration: %s", input)
+	_, err := conn.DeleteBucketLifecycle(input)
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchLifecycleConfiguration", "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting S3 bucket lifecycle configuration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
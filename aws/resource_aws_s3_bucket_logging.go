@@ -0,0 +1,125 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsS3BucketLogging() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketLoggingPut,
+		Read:   resourceAwsS3BucketLoggingRead,
+		Update: resourceAwsS3BucketLoggingPut,
+		Delete: resourceAwsS3BucketLoggingDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"target_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketLoggingPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketLoggingInput{
+		Bucket: aws.String(bucket),
+		BucketLoggingStatus: &s3.BucketLoggingStatus{
+			LoggingEnabled: expandS3LoggingEnabled(map[string]interface{}{
+				"target_bucket": d.Get("target_bucket"),
+				"target_prefix": d.Get("target_prefix"),
+			}),
+		},
+	}
+
+	log.Printf("[DEBUG] Putting S3 bucket logging: %s", input)
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.PutBucketLogging(input)
+		if err != nil {
+			if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error putting S3 bucket logging: %s", err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketLoggingRead(d, meta)
+}
+
+func resourceAwsS3BucketLoggingRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.GetBucketLoggingInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading S3 bucket logging: %s", input)
+	output, err := conn.GetBucketLogging(input)
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+			log.Printf("[WARN] S3 bucket (%s) not found, removing logging from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading S3 bucket logging (%s): %s", d.Id(), err)
+	}
+
+	if output.LoggingEnabled == nil {
+		log.Printf("[WARN] S3 bucket (%s) logging not enabled, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("target_bucket", output.LoggingEnabled.TargetBucket)
+	d.Set("target_prefix", output.LoggingEnabled.TargetPrefix)
+
+	return nil
+}
+
+func resourceAwsS3BucketLoggingDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.PutBucketLoggingInput{
+		Bucket:              aws.String(d.Id()),
+		BucketLoggingStatus: &s3.BucketLoggingStatus{},
+	}
+
+	log.Printf("[DEBUG] Disabling S3 bucket logging: %s", input)
+	_, err := conn.PutBucketLogging(input)
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+			return nil
+		}
+		return fmt.Errorf("error disabling S3 bucket logging (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
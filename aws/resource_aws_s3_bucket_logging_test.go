@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSS3BucketLogging_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_logging.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketLoggingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketLoggingConfig(rInt, "log/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketLoggingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_bucket", fmt.Sprintf("tf-test-log-bucket-%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "target_prefix", "log/"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSS3BucketLoggingConfig(rInt, "logs/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketLoggingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_prefix", "logs/"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketLoggingExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 bucket logging ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+		output, err := conn.GetBucketLogging(&s3.GetBucketLoggingInput{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if output.LoggingEnabled == nil {
+			return fmt.Errorf("S3 bucket logging not enabled for %s", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSS3BucketLoggingDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_logging" {
+			continue
+		}
+
+		output, err := conn.GetBucketLogging(&s3.GetBucketLoggingInput{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+				continue
+			}
+			return err
+		}
+
+		if output.LoggingEnabled != nil {
+			return fmt.Errorf("S3 bucket logging still enabled for %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSS3BucketLoggingConfig(randInt int, prefix string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "log_bucket" {
+  bucket = "tf-test-log-bucket-%d"
+  acl    = "log-delivery-write"
+}
+
+resource "aws_s3_bucket" "bucket" {
+  bucket                    = "tf-test-bucket-%d"
+  standalone_configurations = ["logging"]
+}
+
+resource "aws_s3_bucket_logging" "test" {
+  bucket        = "${aws_s3_bucket.bucket.id}"
+  target_bucket = "${aws_s3_bucket.log_bucket.id}"
+  target_prefix = "%s"
+}
+`, randInt, randInt, prefix)
+}
@@ -2,7 +2,9 @@ package aws
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
@@ -18,15 +20,18 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 )
 
 func resourceAwsS3BucketObject() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsS3BucketObjectPut,
 		Read:   resourceAwsS3BucketObjectRead,
-		Update: resourceAwsS3BucketObjectPut,
+		Update: resourceAwsS3BucketObjectUpdate,
 		Delete: resourceAwsS3BucketObjectDelete,
 
+		CustomizeDiff: resourceAwsS3BucketObjectCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:     schema.TypeString,
@@ -129,14 +134,25 @@ func resourceAwsS3BucketObject() *schema.Resource {
 
 			"etag": {
 				Type: schema.TypeString,
-				// This will conflict with SSE-C and SSE-KMS encryption and multi-part upload
-				// if/when it's actually implemented. The Etag then won't match raw-file MD5.
+				// This will conflict with SSE-C and SSE-KMS encryption and multi-part upload.
+				// The Etag then won't match raw-file MD5, use content_sha256 instead.
 				// See http://docs.aws.amazon.com/AmazonS3/latest/API/RESTCommonResponseHeaders.html
 				Optional:      true,
 				Computed:      true,
 				ConflictsWith: []string{"kms_key_id", "server_side_encryption"},
 			},
 
+			"content_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: validateMetadataIsLowerCase,
+			},
+
 			"version_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -157,40 +173,31 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 
 	restricted := meta.(*AWSClient).IsChinaCloud()
 
-	var body io.ReadSeeker
+	// Until the upload succeeds, keep the previous content_sha256 in state so
+	// that a failed upload is planned again rather than recorded as done.
+	d.Partial(true)
 
-	if v, ok := d.GetOk("source"); ok {
-		source := v.(string)
-		path, err := homedir.Expand(source)
-		if err != nil {
-			return fmt.Errorf("Error expanding homedir in source (%s): %s", source, err)
-		}
-		file, err := os.Open(path)
-		if err != nil {
-			return fmt.Errorf("Error opening S3 bucket object source (%s): %s", source, err)
-		}
-
-		body = file
-	} else if v, ok := d.GetOk("content"); ok {
-		content := v.(string)
-		body = bytes.NewReader([]byte(content))
-	} else if v, ok := d.GetOk("content_base64"); ok {
-		content := v.(string)
-		// We can't do streaming decoding here (with base64.NewDecoder) because
-		// the AWS SDK requires an io.ReadSeeker but a base64 decoder can't seek.
-		contentRaw, err := base64.StdEncoding.DecodeString(content)
-		if err != nil {
-			return fmt.Errorf("error decoding content_base64: %s", err)
-		}
-		body = bytes.NewReader(contentRaw)
-	} else {
+	body, err := s3BucketObjectBody(d.Get("source").(string), d.Get("content").(string), d.Get("content_base64").(string))
+	if err != nil {
+		return err
+	}
+	if body == nil {
 		return fmt.Errorf("Must specify \"source\", \"content\", or \"content_base64\" field")
 	}
+	if c, ok := body.(io.Closer); ok {
+		defer c.Close()
+	}
+
+	contentSha256, err := s3BucketObjectContentSha256(body)
+	if err != nil {
+		return fmt.Errorf("error hashing S3 bucket object content: %s", err)
+	}
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 
-	putInput := &s3.PutObjectInput{
+	// The upload manager switches to a multipart upload for large bodies.
+	putInput := &s3manager.UploadInput{
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
 		ACL:    aws.String(d.Get("acl").(string)),
@@ -247,19 +254,98 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		putInput.WebsiteRedirectLocation = aws.String(v.(string))
 	}
 
-	resp, err := s3conn.PutObject(putInput)
+	if v, ok := d.GetOk("metadata"); ok {
+		putInput.Metadata = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	uploader := s3manager.NewUploaderWithClient(s3conn)
+	resp, err := uploader.Upload(putInput)
 	if err != nil {
+		o, _ := d.GetChange("content_sha256")
+		d.Set("content_sha256", o)
+		d.SetPartial("content_sha256")
 		return fmt.Errorf("Error putting object in S3 bucket (%s): %s", bucket, err)
 	}
 
-	// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
-	d.Set("etag", strings.Trim(*resp.ETag, `"`))
+	d.Partial(false)
 
-	d.Set("version_id", resp.VersionId)
+	d.Set("content_sha256", contentSha256)
+	d.Set("version_id", resp.VersionID)
 	d.SetId(key)
 	return resourceAwsS3BucketObjectRead(d, meta)
 }
 
+func resourceAwsS3BucketObjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	// Changes to anything other than the tags require the object to be uploaded again.
+	for _, key := range []string{
+		"acl",
+		"cache_control",
+		"content_base64",
+		"content_disposition",
+		"content_encoding",
+		"content_language",
+		"content_type",
+		"content",
+		"etag",
+		"kms_key_id",
+		"metadata",
+		"server_side_encryption",
+		"source",
+		"storage_class",
+		"website_redirect",
+	} {
+		if d.HasChange(key) {
+			return resourceAwsS3BucketObjectPut(d, meta)
+		}
+	}
+
+	// Objects created before content_sha256 was tracked, and imported objects,
+	// have no hash in state yet. With the etag unchanged the hash is only
+	// recorded, rather than uploading every such object again.
+	if d.HasChange("content_sha256") {
+		if o, _ := d.GetChange("content_sha256"); o.(string) != "" {
+			return resourceAwsS3BucketObjectPut(d, meta)
+		}
+		log.Printf("[DEBUG] Recording content_sha256 of S3 bucket object (%s) without uploading it", d.Id())
+	}
+
+	if d.HasChange("tags") {
+		s3conn := meta.(*AWSClient).s3conn
+
+		if meta.(*AWSClient).IsChinaCloud() {
+			return fmt.Errorf("This region does not allow for tags on S3 objects")
+		}
+
+		bucket := d.Get("bucket").(string)
+		key := d.Get("key").(string)
+
+		if tags := d.Get("tags").(map[string]interface{}); len(tags) > 0 {
+			input := &s3.PutObjectTaggingInput{
+				Bucket: aws.String(bucket),
+				Key:    aws.String(key),
+				Tagging: &s3.Tagging{
+					TagSet: tagsFromMapS3(tags),
+				},
+			}
+			log.Printf("[DEBUG] Putting S3 object tags: %s", input)
+			if _, err := s3conn.PutObjectTagging(input); err != nil {
+				return fmt.Errorf("error putting S3 object tags (bucket: %s, key: %s): %s", bucket, key, err)
+			}
+		} else {
+			input := &s3.DeleteObjectTaggingInput{
+				Bucket: aws.String(bucket),
+				Key:    aws.String(key),
+			}
+			log.Printf("[DEBUG] Deleting S3 object tags: %s", input)
+			if _, err := s3conn.DeleteObjectTagging(input); err != nil {
+				return fmt.Errorf("error deleting S3 object tags (bucket: %s, key: %s): %s", bucket, key, err)
+			}
+		}
+	}
+
+	return resourceAwsS3BucketObjectRead(d, meta)
+}
+
 func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) error {
 	s3conn := meta.(*AWSClient).s3conn
 
@@ -294,6 +380,15 @@ func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("server_side_encryption", resp.ServerSideEncryption)
 	d.Set("website_redirect", resp.WebsiteRedirectLocation)
 
+	// Metadata keys are returned in canonical HTTP header form.
+	metadata := make(map[string]interface{}, len(resp.Metadata))
+	for k, v := range resp.Metadata {
+		metadata[strings.ToLower(k)] = aws.StringValue(v)
+	}
+	if err := d.Set("metadata", metadata); err != nil {
+		return fmt.Errorf("error setting metadata: %s", err)
+	}
+
 	// Only set non-default KMS key ID (one that doesn't match default)
 	if resp.SSEKMSKeyId != nil {
 		// retrieve S3 KMS Default Master Key
@@ -310,6 +405,7 @@ func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) err
 			d.Set("kms_key_id", resp.SSEKMSKeyId)
 		}
 	}
+	// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
 	d.Set("etag", strings.Trim(*resp.ETag, `"`))
 
 	// The "STANDARD" (which is also the default) storage
@@ -377,3 +473,81 @@ func resourceAwsS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) e
 
 	return nil
 }
+
+func resourceAwsS3BucketObjectCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"source", "content", "content_base64"} {
+		if !diff.NewValueKnown(key) {
+			return diff.SetNewComputed("content_sha256")
+		}
+	}
+
+	body, err := s3BucketObjectBody(diff.Get("source").(string), diff.Get("content").(string), diff.Get("content_base64").(string))
+	if err != nil {
+		// The source may be created by another resource during apply.
+		log.Printf("[WARN] Unable to hash S3 bucket object content during plan: %s", err)
+		return diff.SetNewComputed("content_sha256")
+	}
+	if body == nil {
+		return nil
+	}
+	if c, ok := body.(io.Closer); ok {
+		defer c.Close()
+	}
+
+	contentSha256, err := s3BucketObjectContentSha256(body)
+	if err != nil {
+		return fmt.Errorf("error hashing S3 bucket object content: %s", err)
+	}
+
+	if diff.Get("content_sha256").(string) != contentSha256 {
+		return diff.SetNew("content_sha256", contentSha256)
+	}
+
+	return nil
+}
+
+// s3BucketObjectBody returns the object body defined by the source, content
+// or content_base64 argument, or nil if none of them is set.
+func s3BucketObjectBody(source, content, contentBase64 string) (io.ReadSeeker, error) {
+	if source != "" {
+		path, err := homedir.Expand(source)
+		if err != nil {
+			return nil, fmt.Errorf("Error expanding homedir in source (%s): %s", source, err)
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("Error opening S3 bucket object source (%s): %s", source, err)
+		}
+
+		return file, nil
+	}
+
+	if content != "" {
+		return bytes.NewReader([]byte(content)), nil
+	}
+
+	if contentBase64 != "" {
+		// We can't do streaming decoding here (with base64.NewDecoder) because
+		// the AWS SDK requires an io.ReadSeeker but a base64 decoder can't seek.
+		contentRaw, err := base64.StdEncoding.DecodeString(contentBase64)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding content_base64: %s", err)
+		}
+		return bytes.NewReader(contentRaw), nil
+	}
+
+	return nil, nil
+}
+
+// s3BucketObjectContentSha256 returns the hex encoded SHA-256 of the body and
+// rewinds it so it can be uploaded afterwards.
+func s3BucketObjectContentSha256(body io.ReadSeeker) (string, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, body); err != nil {
+		return "", err
+	}
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -1,10 +1,12 @@
 package aws
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"reflect"
+	"regexp"
 	"sort"
 	"testing"
 
@@ -547,6 +549,245 @@ func TestAccAWSS3BucketObject_tags(t *testing.T) {
 	})
 }
 
+func TestS3BucketObjectContentSha256(t *testing.T) {
+	body, err := s3BucketObjectBody("", "stuff", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hash, err := s3BucketObjectContentSha256(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "35bafb1ce99aef3ab068afbaabae8f21fd9b9f02d3a9442e364fa92c0b3eeef0"
+	if hash != expected {
+		t.Fatalf("Expected hash %s, got %s", expected, hash)
+	}
+
+	// The body must be rewound for the upload.
+	content, err := ioutil.ReadAll(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "stuff" {
+		t.Fatalf("Expected body to be rewound, got %q", content)
+	}
+}
+
+func TestAccAWSS3BucketObject_contentSha256Updates(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "tf-acc-s3-obj-sha256-updates")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	rInt := acctest.RandInt()
+	err = ioutil.WriteFile(tmpFile.Name(), []byte("initial object state"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var obj s3.GetObjectOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSS3BucketObjectConfigSource(rInt, tmpFile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &obj),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "content_sha256", "a80e3988a66d8f8a2784c76994c40e10e319c0801bb4f563f35e36204943acd8"),
+				),
+			},
+			resource.TestStep{
+				PreConfig: func() {
+					err = ioutil.WriteFile(tmpFile.Name(), []byte("modified object"), 0644)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccAWSS3BucketObjectConfigSource(rInt, tmpFile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &obj),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "content_sha256", "6d75f5e9e60901ce9428a34d06af677e9724f7d152eebf74a7764aabadb42205"),
+					testAccCheckAWSS3BucketObjectBody(&obj, "modified object"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketObject_contentSha256Upgrade(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "tf-acc-s3-obj-sha256-upgrade")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	rInt := acctest.RandInt()
+	err = ioutil.WriteFile(tmpFile.Name(), []byte("initial object state"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var obj1, obj2 s3.GetObjectOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSS3BucketObjectConfig_updatesWithVersioning(rInt, tmpFile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &obj1),
+					// Simulate an object created before content_sha256 was tracked.
+					testAccCheckAWSS3BucketObjectClearContentSha256("aws_s3_bucket_object.object"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			resource.TestStep{
+				Config: testAccAWSS3BucketObjectConfig_updatesWithVersioning(rInt, tmpFile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &obj2),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "content_sha256", "a80e3988a66d8f8a2784c76994c40e10e319c0801bb4f563f35e36204943acd8"),
+					// Recording the hash must not upload a new version of the object.
+					func(s *terraform.State) error {
+						if aws.StringValue(obj1.VersionId) != aws.StringValue(obj2.VersionId) {
+							return fmt.Errorf("Expected version ID to be unchanged, got %s and %s", aws.StringValue(obj1.VersionId), aws.StringValue(obj2.VersionId))
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketObjectClearContentSha256(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		delete(rs.Primary.Attributes, "content_sha256")
+		return nil
+	}
+}
+
+func TestAccAWSS3BucketObject_multipart(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "tf-acc-s3-obj-multipart")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	// Anything larger than the 5 MB minimum part size is uploaded in parts.
+	rInt := acctest.RandInt()
+	err = ioutil.WriteFile(tmpFile.Name(), bytes.Repeat([]byte("a"), 6*1024*1024), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var obj s3.GetObjectOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSS3BucketObjectConfigSource(rInt, tmpFile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &obj),
+					resource.TestMatchResourceAttr("aws_s3_bucket_object.object", "etag", regexp.MustCompile(`-2$`)),
+					resource.TestCheckResourceAttrSet("aws_s3_bucket_object.object", "content_sha256"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketObject_metadata(t *testing.T) {
+	rInt := acctest.RandInt()
+	var obj s3.GetObjectOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSS3BucketObjectConfig_withMetadata(rInt, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &obj),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "metadata.%", "2"),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "metadata.key1", "value1"),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "metadata.key2", "value two"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSS3BucketObjectConfig_withMetadata(rInt, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &obj),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "metadata.key1", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketObject_tagsUpdate(t *testing.T) {
+	rInt := acctest.RandInt()
+	var obj1, obj2 s3.GetObjectOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSS3BucketObjectConfig_withVersionedTags(rInt, "Value One"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &obj1),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "tags.%", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSS3BucketObjectConfig_withVersionedTags(rInt, "Value Two"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &obj2),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "tags.Key1", "Value Two"),
+					// Tag changes must not upload a new version of the object.
+					func(s *terraform.State) error {
+						if aws.StringValue(obj1.VersionId) != aws.StringValue(obj2.VersionId) {
+							return fmt.Errorf("Expected version ID to be unchanged, got %s and %s", aws.StringValue(obj1.VersionId), aws.StringValue(obj2.VersionId))
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketObjectBody(obj *s3.GetObjectOutput, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		body, err := ioutil.ReadAll(obj.Body)
+		if err != nil {
+			return fmt.Errorf("failed to read body: %s", err)
+		}
+		obj.Body.Close()
+
+		if got := string(body); got != want {
+			return fmt.Errorf("wrong result body %q; want %q", got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccAWSS3BucketObjectConfigSource(randInt int, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "object_bucket" {
@@ -715,3 +956,41 @@ resource "aws_s3_bucket_object" "object" {
 }
 `, randInt)
 }
+
+func testAccAWSS3BucketObjectConfig_withMetadata(randInt int, value string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket" {
+	bucket = "tf-object-test-bucket-%d"
+}
+
+resource "aws_s3_bucket_object" "object" {
+	bucket = "${aws_s3_bucket.object_bucket.bucket}"
+	key = "test-key"
+	content = "stuff"
+	metadata {
+		key1 = "%s"
+		key2 = "value two"
+	}
+}
+`, randInt, value)
+}
+
+func testAccAWSS3BucketObjectConfig_withVersionedTags(randInt int, value string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket" {
+	bucket = "tf-object-test-bucket-%d"
+	versioning {
+		enabled = true
+	}
+}
+
+resource "aws_s3_bucket_object" "object" {
+	bucket = "${aws_s3_bucket.object_bucket.bucket}"
+	key = "test-key"
+	content = "stuff"
+	tags {
+		Key1 = "%s"
+	}
+}
+`, randInt, value)
+}
@@ -0,0 +1,139 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsS3BucketReplicationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketReplicationConfigurationPut,
+		Read:   resourceAwsS3BucketReplicationConfigurationRead,
+		Update: resourceAwsS3BucketReplicationConfigurationPut,
+		Delete: resourceAwsS3BucketReplicationConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"rules": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Set:      rulesHash,
+				Elem:     resourceAwsS3BucketReplicationRule(),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketReplicationConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+
+	// Versioning may be managed outside of this resource, so check the
+	// bucket itself rather than any configuration.
+	versioning, err := conn.GetBucketVersioning(&s3.GetBucketVersioningInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		return fmt.Errorf("error reading S3 bucket (%s) versioning: %s", bucket, err)
+	}
+	if aws.StringValue(versioning.Status) != s3.BucketVersioningStatusEnabled {
+		return fmt.Errorf("versioning must be enabled to allow S3 bucket replication")
+	}
+
+	input := &s3.PutBucketReplicationInput{
+		Bucket: aws.String(bucket),
+		ReplicationConfiguration: &s3.ReplicationConfiguration{
+			Role:  aws.String(d.Get("role").(string)),
+			Rules: expandS3ReplicationRules(d.Get("rules").(*schema.Set).List()),
+		},
+	}
+
+	log.Printf("[DEBUG] Putting S3 bucket replication configuration: %s", input)
+	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.PutBucketReplication(input)
+		if err != nil {
+			if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error putting S3 bucket replication configuration: %s", err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketReplicationConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketReplicationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.GetBucketReplicationInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading S3 bucket replication configuration: %s", input)
+	output, err := conn.GetBucketReplication(input)
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "ReplicationConfigurationNotFoundError", "") {
+			log.Printf("[WARN] S3 bucket replication configuration (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading S3 bucket replication configuration (%s): %s", d.Id(), err)
+	}
+
+	rc := flattenAwsS3BucketReplicationConfiguration(output.ReplicationConfiguration)
+	if len(rc) == 0 {
+		log.Printf("[WARN] S3 bucket replication configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("role", rc[0]["role"])
+	if err := d.Set("rules", rc[0]["rules"]); err != nil {
+		return fmt.Errorf("error setting rules: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketReplicationConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.DeleteBucketReplicationInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting S3 bucket replication configuration: %s", input)
+	_, err := conn.DeleteBucketReplication(input)
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "ReplicationConfigurationNotFoundError", "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting S3 bucket replication configuration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
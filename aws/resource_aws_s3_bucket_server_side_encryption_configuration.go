@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsS3BucketServerSideEncryptionConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketServerSideEncryptionConfigurationPut,
+		Read:   resourceAwsS3BucketServerSideEncryptionConfigurationRead,
+		Update: resourceAwsS3BucketServerSideEncryptionConfigurationPut,
+		Delete: resourceAwsS3BucketServerSideEncryptionConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Required: true,
+				Elem:     resourceAwsS3BucketServerSideEncryptionRule(),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketServerSideEncryptionConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketEncryptionInput{
+		Bucket: aws.String(bucket),
+		ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{
+			Rules: expandS3ServerSideEncryptionRules(d.Get("rule").([]interface{})),
+		},
+	}
+
+	log.Printf("[DEBUG] Putting S3 bucket server side encryption configuration: %s", input)
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.PutBucketEncryption(input)
+		if err != nil {
+			if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error putting S3 bucket server side encryption configuration: %s", err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketServerSideEncryptionConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketServerSideEncryptionConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.GetBucketEncryptionInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading S3 bucket server side encryption configuration: %s", input)
+	output, err := conn.GetBucketEncryption(input)
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "ServerSideEncryptionConfigurationNotFoundError", "") {
+			log.Printf("[WARN] S3 bucket server side encryption configuration (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading S3 bucket server side encryption configuration (%s): %s", d.Id(), err)
+	}
+
+	if output.ServerSideEncryptionConfiguration == nil {
+		log.Printf("[WARN] S3 bucket server side encryption configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("bucket", d.Id())
+	c := flattenAwsS3ServerSideEncryptionConfiguration(output.ServerSideEncryptionConfiguration)
+	if err := d.Set("rule", c[0]["rule"]); err != nil {
+		return fmt.Errorf("error setting rule: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketServerSideEncryptionConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.DeleteBucketEncryptionInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting S3 bucket server side encryption configuration: %s", input)
+	_, err := conn.DeleteBucketEncryption(input)
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "ServerSideEncryptionConfigurationNotFoundError", "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting S3 bucket server side encryption configuration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
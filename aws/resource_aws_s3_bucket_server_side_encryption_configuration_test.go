@@ -0,0 +1,109 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSS3BucketServerSideEncryptionConfiguration_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_server_side_encryption_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketServerSideEncryptionConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketServerSideEncryptionConfigurationConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketServerSideEncryptionConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.apply_server_side_encryption_by_default.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.apply_server_side_encryption_by_default.0.sse_algorithm", "aws:kms"),
+					resource.TestCheckResourceAttrPair(resourceName, "rule.0.apply_server_side_encryption_by_default.0.kms_master_key_id", "aws_kms_key.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketServerSideEncryptionConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 bucket server side encryption configuration ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+		_, err := conn.GetBucketEncryption(&s3.GetBucketEncryptionInput{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSS3BucketServerSideEncryptionConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_server_side_encryption_configuration" {
+			continue
+		}
+
+		_, err := conn.GetBucketEncryption(&s3.GetBucketEncryptionInput{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "ServerSideEncryptionConfigurationNotFoundError", "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("S3 bucket server side encryption configuration still exists for %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSS3BucketServerSideEncryptionConfigurationConfig(randInt int) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = "KMS Key for Bucket Testing %d"
+  deletion_window_in_days = 10
+}
+
+resource "aws_s3_bucket" "bucket" {
+  bucket                    = "tf-test-bucket-%d"
+  standalone_configurations = ["server_side_encryption_configuration"]
+}
+
+resource "aws_s3_bucket_server_side_encryption_configuration" "test" {
+  bucket = "${aws_s3_bucket.bucket.id}"
+
+  rule {
+    apply_server_side_encryption_by_default {
+      kms_master_key_id = "${aws_kms_key.test.arn}"
+      sse_algorithm     = "aws:kms"
+    }
+  }
+}
+`, randInt, randInt)
+}
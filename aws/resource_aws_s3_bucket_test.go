@@ -7,9 +7,12 @@ import (
 	"reflect"
 	"regexp"
 	"strconv"
+	"sync"
 	"testing"
 	"text/template"
+	"time"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -988,6 +991,163 @@ func TestAWSS3BucketName(t *testing.T) {
 	}
 }
 
+func TestReadS3BucketConcurrently(t *testing.T) {
+	const concurrency = 3
+
+	var mu sync.Mutex
+	var inFlight, maxInFlight int
+	var order []int
+
+	var reads []*s3BucketSubRead
+	for i := 0; i < 10; i++ {
+		i := i
+		reads = append(reads, &s3BucketSubRead{
+			fetch: func() (interface{}, error) {
+				mu.Lock()
+				inFlight++
+				if inFlight > maxInFlight {
+					maxInFlight = inFlight
+				}
+				mu.Unlock()
+
+				time.Sleep(10 * time.Millisecond)
+
+				mu.Lock()
+				inFlight--
+				mu.Unlock()
+
+				if i == 7 {
+					return nil, awserr.New("AccessDenied", "Access Denied", nil)
+				}
+				return i, nil
+			},
+			set: func(resp interface{}, err error) error {
+				if i == 7 {
+					if !isAWSErr(err, "AccessDenied", "") {
+						return fmt.Errorf("expected AccessDenied error for sub-read %d, got: %v", i, err)
+					}
+					order = append(order, i)
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				if resp.(int) != i {
+					return fmt.Errorf("expected response %d, got %v", i, resp)
+				}
+				order = append(order, i)
+				return nil
+			},
+		})
+	}
+
+	if err := readS3BucketConcurrently(reads, concurrency); err != nil {
+		t.Fatal(err)
+	}
+
+	if maxInFlight > concurrency {
+		t.Fatalf("expected at most %d concurrent sub-reads, got %d", concurrency, maxInFlight)
+	}
+	if expected := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}; !reflect.DeepEqual(order, expected) {
+		t.Fatalf("expected sub-reads to be set in order %v, got %v", expected, order)
+	}
+}
+
+func TestReadS3BucketConcurrently_setError(t *testing.T) {
+	var called bool
+	reads := []*s3BucketSubRead{
+		{
+			fetch: func() (interface{}, error) { return nil, nil },
+			set:   func(interface{}, error) error { return fmt.Errorf("boom") },
+		},
+		{
+			fetch: func() (interface{}, error) { return nil, nil },
+			set: func(interface{}, error) error {
+				called = true
+				return nil
+			},
+		},
+	}
+
+	err := readS3BucketConcurrently(reads, s3BucketReadConcurrency)
+	if err == nil || err.Error() != "boom" {
+		t.Fatalf("expected error from first sub-read, got: %v", err)
+	}
+	if called {
+		t.Fatal("expected sub-reads after a failure not to be set")
+	}
+}
+
+func TestResourceAwsS3BucketStandaloneConfigurations(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "tf-test-bucket",
+		Attributes: map[string]string{
+			"bucket":                             "tf-test-bucket",
+			"acl":                                "private",
+			"force_destroy":                      "false",
+			"website.#":                          "1",
+			"website.0.index_document":           "index.html",
+			"website.0.error_document":           "",
+			"website.0.redirect_all_requests_to": "",
+			"website.0.routing_rules":            "",
+		},
+	}
+
+	testCases := []struct {
+		Name       string
+		Config     map[string]interface{}
+		ExpectDiff bool
+	}{
+		{
+			Name: "removed",
+			Config: map[string]interface{}{
+				"bucket": "tf-test-bucket",
+			},
+			ExpectDiff: true,
+		},
+		{
+			Name: "removed and managed by a standalone resource",
+			Config: map[string]interface{}{
+				"bucket":                    "tf-test-bucket",
+				"standalone_configurations": []interface{}{"website"},
+			},
+			ExpectDiff: false,
+		},
+		{
+			Name: "removed and another setting managed by a standalone resource",
+			Config: map[string]interface{}{
+				"bucket":                    "tf-test-bucket",
+				"standalone_configurations": []interface{}{"cors_rule"},
+			},
+			ExpectDiff: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		rc, err := config.NewRawConfig(tc.Config)
+		if err != nil {
+			t.Fatalf("%s: %s", tc.Name, err)
+		}
+
+		diff, err := resourceAwsS3Bucket().Diff(state, terraform.NewResourceConfig(rc), nil)
+		if err != nil {
+			t.Fatalf("%s: %s", tc.Name, err)
+		}
+
+		var hasDiff bool
+		if diff != nil {
+			for k := range diff.Attributes {
+				if strings.HasPrefix(k, "website.") {
+					hasDiff = true
+				}
+			}
+		}
+		if hasDiff != tc.ExpectDiff {
+			t.Errorf("%s: expected website diff to be %t, got %t (%#v)", tc.Name, tc.ExpectDiff, hasDiff, diff)
+		}
+	}
+}
+
 func TestBucketRegionalDomainName(t *testing.T) {
 	const bucket = "bucket-name"
 
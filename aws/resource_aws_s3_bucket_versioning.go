@@ -0,0 +1,151 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsS3BucketVersioning() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketVersioningPut,
+		Read:   resourceAwsS3BucketVersioningRead,
+		Update: resourceAwsS3BucketVersioningPut,
+		Delete: resourceAwsS3BucketVersioningDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"mfa_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"mfa": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketVersioningPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketVersioningInput{
+		Bucket: aws.String(bucket),
+		VersioningConfiguration: expandS3VersioningConfiguration(map[string]interface{}{
+			"enabled":    d.Get("enabled"),
+			"mfa_delete": d.Get("mfa_delete"),
+		}),
+	}
+
+	if v, ok := d.GetOk("mfa"); ok {
+		input.MFA = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Putting S3 bucket versioning: %s", input)
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.PutBucketVersioning(input)
+		if err != nil {
+			if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error putting S3 bucket versioning: %s", err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketVersioningRead(d, meta)
+}
+
+func resourceAwsS3BucketVersioningRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.GetBucketVersioningInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading S3 bucket versioning: %s", input)
+	output, err := conn.GetBucketVersioning(input)
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+			log.Printf("[WARN] S3 bucket (%s) not found, removing versioning from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading S3 bucket versioning (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("enabled", aws.StringValue(output.Status) == s3.BucketVersioningStatusEnabled)
+	d.Set("mfa_delete", aws.StringValue(output.MFADelete) == s3.MFADeleteStatusEnabled)
+
+	return nil
+}
+
+func resourceAwsS3BucketVersioningDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	output, err := conn.GetBucketVersioning(&s3.GetBucketVersioningInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+			return nil
+		}
+		return fmt.Errorf("error reading S3 bucket versioning (%s): %s", d.Id(), err)
+	}
+
+	// A bucket that was never versioned has no status, and suspending its
+	// versioning would put it in a versioning state for the first time.
+	if aws.StringValue(output.Status) == "" {
+		log.Printf("[DEBUG] S3 bucket (%s) was never versioned, nothing to suspend", d.Id())
+		return nil
+	}
+
+	// Versioning cannot be removed from a bucket once enabled, only suspended.
+	input := &s3.PutBucketVersioningInput{
+		Bucket: aws.String(d.Id()),
+		VersioningConfiguration: &s3.VersioningConfiguration{
+			Status: aws.String(s3.BucketVersioningStatusSuspended),
+		},
+	}
+
+	if v, ok := d.GetOk("mfa"); ok {
+		input.MFA = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Suspending S3 bucket versioning: %s", input)
+	_, err = conn.PutBucketVersioning(input)
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+			return nil
+		}
+		return fmt.Errorf("error suspending S3 bucket versioning (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestS3BucketVersioningDelete_neverVersioned(t *testing.T) {
+	s3Endpoints := []*awsMockEndpoint{
+		&awsMockEndpoint{
+			Request: &awsMockRequest{"GET", "/tf-test-bucket?versioning=", ""},
+			Response: &awsMockResponse{200, `<?xml version="1.0" encoding="UTF-8"?>
+<VersioningConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"/>`, "application/xml"},
+		},
+	}
+	closeFunc, sess, err := getMockedAwsApiSession("S3", s3Endpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeFunc()
+	conn := s3.New(sess, &aws.Config{S3ForcePathStyle: aws.Bool(true)})
+
+	d := schema.TestResourceDataRaw(t, resourceAwsS3BucketVersioning().Schema, map[string]interface{}{
+		"bucket":  "tf-test-bucket",
+		"enabled": false,
+	})
+	d.SetId("tf-test-bucket")
+
+	// The mocked API rejects any request to suspend versioning.
+	if err := resourceAwsS3BucketVersioningDelete(d, &AWSClient{s3conn: conn}); err != nil {
+		t.Fatalf("expected versioning of a never versioned bucket to be left alone, got: %s", err)
+	}
+}
+
+func TestAccAWSS3BucketVersioning_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_versioning.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketVersioningConfig(rInt, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketVersioningStatus(resourceName, s3.BucketVersioningStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "mfa_delete", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"mfa"},
+			},
+			{
+				Config: testAccAWSS3BucketVersioningConfig(rInt, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketVersioningStatus(resourceName, s3.BucketVersioningStatusSuspended),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketVersioningStatus(n, status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 bucket versioning ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+		output, err := conn.GetBucketVersioning(&s3.GetBucketVersioningInput{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if v := aws.StringValue(output.Status); v != status {
+			return fmt.Errorf("Expected S3 bucket versioning status %q, got %q", status, v)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSS3BucketVersioningConfig(randInt int, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+  bucket                    = "tf-test-bucket-%d"
+  standalone_configurations = ["versioning"]
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket  = "${aws_s3_bucket.bucket.id}"
+  enabled = %t
+}
+`, randInt, enabled)
+}
@@ -0,0 +1,164 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+)
+
+func resourceAwsS3BucketWebsiteConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketWebsiteConfigurationPut,
+		Read:   resourceAwsS3BucketWebsiteConfigurationRead,
+		Update: resourceAwsS3BucketWebsiteConfigurationPut,
+		Delete: resourceAwsS3BucketWebsiteConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"index_document": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"error_document": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"redirect_all_requests_to": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"index_document", "error_document", "routing_rules"},
+			},
+			"routing_rules": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateJsonString,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"website_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"website_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketWebsiteConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+
+	websiteConfiguration, err := expandS3WebsiteConfiguration(map[string]interface{}{
+		"index_document":           d.Get("index_document"),
+		"error_document":           d.Get("error_document"),
+		"redirect_all_requests_to": d.Get("redirect_all_requests_to"),
+		"routing_rules":            d.Get("routing_rules"),
+	})
+	if err != nil {
+		return err
+	}
+
+	input := &s3.PutBucketWebsiteInput{
+		Bucket:               aws.String(bucket),
+		WebsiteConfiguration: websiteConfiguration,
+	}
+
+	log.Printf("[DEBUG] Putting S3 bucket website configuration: %s", input)
+	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.PutBucketWebsite(input)
+		if err != nil {
+			if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error putting S3 bucket website configuration: %s", err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketWebsiteConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketWebsiteConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.GetBucketWebsiteInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading S3 bucket website configuration: %s", input)
+	output, err := conn.GetBucketWebsite(input)
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchWebsiteConfiguration", "") {
+			log.Printf("[WARN] S3 bucket website configuration (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading S3 bucket website configuration (%s): %s", d.Id(), err)
+	}
+
+	w, err := flattenS3WebsiteConfiguration(output)
+	if err != nil {
+		return err
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("index_document", w["index_document"])
+	d.Set("error_document", w["error_document"])
+	d.Set("redirect_all_requests_to", w["redirect_all_requests_to"])
+	d.Set("routing_rules", w["routing_rules"])
+
+	location, err := conn.GetBucketLocation(&s3.GetBucketLocationInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error reading S3 bucket (%s) location: %s", d.Id(), err)
+	}
+
+	endpoint := WebsiteEndpoint(d.Id(), aws.StringValue(location.LocationConstraint))
+	d.Set("website_endpoint", endpoint.Endpoint)
+	d.Set("website_domain", endpoint.Domain)
+
+	return nil
+}
+
+func resourceAwsS3BucketWebsiteConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.DeleteBucketWebsiteInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting S3 bucket website configuration: %s", input)
+	_, err := conn.DeleteBucketWebsite(input)
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchWebsiteConfiguration", "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting S3 bucket website configuration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSS3BucketWebsiteConfiguration_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	region := testAccGetRegion()
+	resourceName := "aws_s3_bucket_website_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketWebsiteConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketWebsiteConfigurationConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketWebsiteConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "index_document", "index.html"),
+					resource.TestCheckResourceAttr(resourceName, "error_document", "error.html"),
+					resource.TestCheckResourceAttr(resourceName, "website_endpoint", testAccWebsiteEndpoint(rInt, region)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSS3BucketWebsiteConfigurationConfigRedirect(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketWebsiteConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "index_document", ""),
+					resource.TestCheckResourceAttr(resourceName, "redirect_all_requests_to", "hashicorp.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketWebsiteConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 bucket website configuration ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+		_, err := conn.GetBucketWebsite(&s3.GetBucketWebsiteInput{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSS3BucketWebsiteConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_website_configuration" {
+			continue
+		}
+
+		_, err := conn.GetBucketWebsite(&s3.GetBucketWebsiteInput{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchWebsiteConfiguration", "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("S3 bucket website configuration still exists for %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSS3BucketWebsiteConfigurationConfig(randInt int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+  bucket                    = "tf-test-bucket-%d"
+  acl                       = "public-read"
+  standalone_configurations = ["website"]
+}
+
+resource "aws_s3_bucket_website_configuration" "test" {
+  bucket         = "${aws_s3_bucket.bucket.id}"
+  index_document = "index.html"
+  error_document = "error.html"
+}
+`, randInt)
+}
+
+func testAccAWSS3BucketWebsiteConfigurationConfigRedirect(randInt int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+  bucket                    = "tf-test-bucket-%d"
+  acl                       = "public-read"
+  standalone_configurations = ["website"]
+}
+
+resource "aws_s3_bucket_website_configuration" "test" {
+  bucket                   = "${aws_s3_bucket.bucket.id}"
+  redirect_all_requests_to = "hashicorp.com"
+}
+`, randInt)
+}
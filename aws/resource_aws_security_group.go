@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsSecurityGroup() *schema.Resource {
@@ -36,6 +37,8 @@ func resourceAwsSecurityGroup() *schema.Resource {
 		SchemaVersion: 1,
 		MigrateState:  resourceAwsSecurityGroupMigrateState,
 
+		CustomizeDiff: resourceAwsSecurityGroupCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:          schema.TypeString,
@@ -217,6 +220,15 @@ func resourceAwsSecurityGroup() *schema.Resource {
 				Default:  false,
 				Optional: true,
 			},
+
+			"rule_management_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					securityGroupRuleManagementModeExclusive,
+					securityGroupRuleManagementModeAdditive,
+				}, false),
+			},
 		},
 	}
 }
@@ -256,6 +268,14 @@ func resourceAwsSecurityGroupCreate(d *schema.ResourceData, meta interface{}) er
 
 	log.Printf("[INFO] Security Group ID: %s", d.Id())
 
+	// Rules targeting the new group are planned again on apply, once its ID
+	// is known, and then see this claim.
+	if d.Get("rule_management_mode").(string) == securityGroupRuleManagementModeExclusive {
+		if err := claimSecurityGroupRulesExclusive(meta.(*AWSClient).resourceOwnership, d.Id()); err != nil {
+			return err
+		}
+	}
+
 	// Wait for the security group to truly exist
 	resp, err := waitForSgToExist(conn, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
@@ -360,8 +380,19 @@ func resourceAwsSecurityGroupRead(d *schema.ResourceData, meta interface{}) erro
 
 	// Loop through the local state of rules, doing a match against the remote
 	// ruleSet we built above.
-	ingressRules := matchRules("ingress", localIngressRules, remoteIngressRules)
-	egressRules := matchRules("egress", localEgressRules, remoteEgressRules)
+	ingressRules, unmatchedIngressRules := matchRulesPartitioned("ingress", localIngressRules, remoteIngressRules)
+	egressRules, unmatchedEgressRules := matchRulesPartitioned("egress", localEgressRules, remoteEgressRules)
+
+	// In additive mode the group only tracks the rules it declares, so rules
+	// added by aws_security_group_rule or outside of Terraform are ignored
+	// rather than being reported as drift and revoked.
+	if d.Get("rule_management_mode").(string) == securityGroupRuleManagementModeAdditive {
+		log.Printf("[DEBUG] Ignoring %d ingress and %d egress rules not declared on Security Group (%s)",
+			len(unmatchedIngressRules), len(unmatchedEgressRules), d.Id())
+	} else {
+		ingressRules = append(ingressRules, unmatchedIngressRules...)
+		egressRules = append(egressRules, unmatchedEgressRules...)
+	}
 
 	sgArn := arn.ARN{
 		AccountID: aws.StringValue(sg.OwnerId),
@@ -486,32 +517,16 @@ func forceRevokeSecurityGroupRules(conn *ec2.EC2, d *schema.ResourceData) error
 	}
 
 	group := sgRaw.(*ec2.SecurityGroup)
-	if len(group.IpPermissions) > 0 {
-		req := &ec2.RevokeSecurityGroupIngressInput{
-			GroupId:       group.GroupId,
-			IpPermissions: group.IpPermissions,
-		}
-		if group.VpcId == nil || *group.VpcId == "" {
-			req.GroupId = nil
-			req.GroupName = group.GroupName
-		}
-		_, err = conn.RevokeSecurityGroupIngress(req)
-
-		if err != nil {
+	for _, batch := range batchIPPerms(group.IpPermissions) {
+		if err := revokeSecurityGroupIPPerms(conn, group, "ingress", batch); err != nil {
 			return fmt.Errorf(
 				"Error revoking security group %s rules: %s",
 				*group.GroupId, err)
 		}
 	}
 
-	if len(group.IpPermissionsEgress) > 0 {
-		req := &ec2.RevokeSecurityGroupEgressInput{
-			GroupId:       group.GroupId,
-			IpPermissions: group.IpPermissionsEgress,
-		}
-		_, err = conn.RevokeSecurityGroupEgress(req)
-
-		if err != nil {
+	for _, batch := range batchIPPerms(group.IpPermissionsEgress) {
+		if err := revokeSecurityGroupIPPerms(conn, group, "egress", batch); err != nil {
 			return fmt.Errorf(
 				"Error revoking security group %s rules: %s",
 				*group.GroupId, err)
@@ -521,6 +536,49 @@ func forceRevokeSecurityGroupRules(conn *ec2.EC2, d *schema.ResourceData) error
 	return nil
 }
 
+const (
+	securityGroupRuleManagementModeExclusive = "exclusive"
+	securityGroupRuleManagementModeAdditive  = "additive"
+
+	// securityGroupRuleBatchSize is the maximum number of rule sources sent
+	// in a single authorize or revoke call.
+	securityGroupRuleBatchSize = 100
+)
+
+func resourceAwsSecurityGroupCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	// A new group has no ID yet, its claim is then recorded on creation.
+	if diff.Id() == "" || diff.Get("rule_management_mode").(string) != securityGroupRuleManagementModeExclusive {
+		return nil
+	}
+
+	return claimSecurityGroupRulesExclusive(meta.(*AWSClient).resourceOwnership, diff.Id())
+}
+
+func securityGroupRuleOwnershipKey(sgID string) string {
+	return fmt.Sprintf("security-group/%s/rules", sgID)
+}
+
+func claimSecurityGroupRulesExclusive(o *resourceOwnership, sgID string) error {
+	if _, ok := o.claimAuthoritative(securityGroupRuleOwnershipKey(sgID), "rule_management_mode"); ok {
+		return securityGroupRuleOwnershipConflict(sgID)
+	}
+	return nil
+}
+
+func claimSecurityGroupRuleStandalone(o *resourceOwnership, sgID string) error {
+	if _, ok := o.claimStandalone(securityGroupRuleOwnershipKey(sgID), "aws_security_group_rule"); ok {
+		return securityGroupRuleOwnershipConflict(sgID)
+	}
+	return nil
+}
+
+func securityGroupRuleOwnershipConflict(sgID string) error {
+	return fmt.Errorf("Security Group (%s) manages its rules exclusively (rule_management_mode = %q), "+
+		"so rules added by aws_security_group_rule would be revoked by the group. Declare the rules inline "+
+		"in the aws_security_group or set its rule_management_mode to %q.",
+		sgID, securityGroupRuleManagementModeExclusive, securityGroupRuleManagementModeAdditive)
+}
+
 func resourceAwsSecurityGroupRuleHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
@@ -712,60 +770,22 @@ func resourceAwsSecurityGroupUpdateRules(
 		if len(remove) > 0 || len(add) > 0 {
 			conn := meta.(*AWSClient).ec2conn
 
-			var err error
-			if len(remove) > 0 {
+			for _, batch := range batchIPPerms(remove) {
 				log.Printf("[DEBUG] Revoking security group %#v %s rule: %#v",
-					group, ruleset, remove)
+					group, ruleset, batch)
 
-				if ruleset == "egress" {
-					req := &ec2.RevokeSecurityGroupEgressInput{
-						GroupId:       group.GroupId,
-						IpPermissions: remove,
-					}
-					_, err = conn.RevokeSecurityGroupEgress(req)
-				} else {
-					req := &ec2.RevokeSecurityGroupIngressInput{
-						GroupId:       group.GroupId,
-						IpPermissions: remove,
-					}
-					if group.VpcId == nil || *group.VpcId == "" {
-						req.GroupId = nil
-						req.GroupName = group.GroupName
-					}
-					_, err = conn.RevokeSecurityGroupIngress(req)
-				}
-
-				if err != nil {
+				if err := revokeSecurityGroupIPPerms(conn, group, ruleset, batch); err != nil {
 					return fmt.Errorf(
 						"Error revoking security group %s rules: %s",
 						ruleset, err)
 				}
 			}
 
-			if len(add) > 0 {
+			for _, batch := range batchIPPerms(add) {
 				log.Printf("[DEBUG] Authorizing security group %#v %s rule: %#v",
-					group, ruleset, add)
-				// Authorize the new rules
-				if ruleset == "egress" {
-					req := &ec2.AuthorizeSecurityGroupEgressInput{
-						GroupId:       group.GroupId,
-						IpPermissions: add,
-					}
-					_, err = conn.AuthorizeSecurityGroupEgress(req)
-				} else {
-					req := &ec2.AuthorizeSecurityGroupIngressInput{
-						GroupId:       group.GroupId,
-						IpPermissions: add,
-					}
-					if group.VpcId == nil || *group.VpcId == "" {
-						req.GroupId = nil
-						req.GroupName = group.GroupName
-					}
-
-					_, err = conn.AuthorizeSecurityGroupIngress(req)
-				}
+					group, ruleset, batch)
 
-				if err != nil {
+				if err := authorizeSecurityGroupIPPerms(conn, group, ruleset, batch); err != nil {
 					return fmt.Errorf(
 						"Error authorizing security group %s rules: %s",
 						ruleset, err)
@@ -776,6 +796,129 @@ func resourceAwsSecurityGroupUpdateRules(
 	return nil
 }
 
+func revokeSecurityGroupIPPerms(conn *ec2.EC2, group *ec2.SecurityGroup, ruleset string, perms []*ec2.IpPermission) error {
+	if ruleset == "egress" {
+		req := &ec2.RevokeSecurityGroupEgressInput{
+			GroupId:       group.GroupId,
+			IpPermissions: perms,
+		}
+		_, err := conn.RevokeSecurityGroupEgress(req)
+		return err
+	}
+
+	req := &ec2.RevokeSecurityGroupIngressInput{
+		GroupId:       group.GroupId,
+		IpPermissions: perms,
+	}
+	if group.VpcId == nil || *group.VpcId == "" {
+		req.GroupId = nil
+		req.GroupName = group.GroupName
+	}
+	_, err := conn.RevokeSecurityGroupIngress(req)
+	return err
+}
+
+func authorizeSecurityGroupIPPerms(conn *ec2.EC2, group *ec2.SecurityGroup, ruleset string, perms []*ec2.IpPermission) error {
+	if ruleset == "egress" {
+		req := &ec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:       group.GroupId,
+			IpPermissions: perms,
+		}
+		_, err := conn.AuthorizeSecurityGroupEgress(req)
+		return err
+	}
+
+	req := &ec2.AuthorizeSecurityGroupIngressInput{
+		GroupId:       group.GroupId,
+		IpPermissions: perms,
+	}
+	if group.VpcId == nil || *group.VpcId == "" {
+		req.GroupId = nil
+		req.GroupName = group.GroupName
+	}
+	_, err := conn.AuthorizeSecurityGroupIngress(req)
+	return err
+}
+
+// batchIPPerms merges permissions that share a protocol and port range, so
+// that each of them is sent to the EC2 API only once, and then splits the
+// result into batches holding at most securityGroupRuleBatchSize sources
+// (CIDR blocks, prefix lists and security groups). Groups with hundreds of
+// rules are then updated with a handful of calls that each stay well within
+// the API request limits.
+func batchIPPerms(perms []*ec2.IpPermission) [][]*ec2.IpPermission {
+	var keys []string
+	merged := make(map[string]*ec2.IpPermission)
+	for _, perm := range perms {
+		key := fmt.Sprintf("%s-%d-%d",
+			protocolForValue(aws.StringValue(perm.IpProtocol)),
+			aws.Int64Value(perm.FromPort),
+			aws.Int64Value(perm.ToPort))
+
+		m, ok := merged[key]
+		if !ok {
+			m = &ec2.IpPermission{
+				FromPort:   perm.FromPort,
+				ToPort:     perm.ToPort,
+				IpProtocol: perm.IpProtocol,
+			}
+			merged[key] = m
+			keys = append(keys, key)
+		}
+		m.IpRanges = append(m.IpRanges, perm.IpRanges...)
+		m.Ipv6Ranges = append(m.Ipv6Ranges, perm.Ipv6Ranges...)
+		m.PrefixListIds = append(m.PrefixListIds, perm.PrefixListIds...)
+		m.UserIdGroupPairs = append(m.UserIdGroupPairs, perm.UserIdGroupPairs...)
+	}
+
+	var batches [][]*ec2.IpPermission
+	var batch []*ec2.IpPermission
+	var batchSources int
+	for _, key := range keys {
+		m := merged[key]
+
+		var piece *ec2.IpPermission
+		addSource := func(add func(*ec2.IpPermission)) {
+			if batchSources == securityGroupRuleBatchSize {
+				batches = append(batches, batch)
+				batch, batchSources, piece = nil, 0, nil
+			}
+			if piece == nil {
+				piece = &ec2.IpPermission{
+					FromPort:   m.FromPort,
+					ToPort:     m.ToPort,
+					IpProtocol: m.IpProtocol,
+				}
+				batch = append(batch, piece)
+			}
+			add(piece)
+			batchSources++
+		}
+
+		for _, v := range m.IpRanges {
+			v := v
+			addSource(func(p *ec2.IpPermission) { p.IpRanges = append(p.IpRanges, v) })
+		}
+		for _, v := range m.Ipv6Ranges {
+			v := v
+			addSource(func(p *ec2.IpPermission) { p.Ipv6Ranges = append(p.Ipv6Ranges, v) })
+		}
+		for _, v := range m.PrefixListIds {
+			v := v
+			addSource(func(p *ec2.IpPermission) { p.PrefixListIds = append(p.PrefixListIds, v) })
+		}
+		for _, v := range m.UserIdGroupPairs {
+			v := v
+			addSource(func(p *ec2.IpPermission) { p.UserIdGroupPairs = append(p.UserIdGroupPairs, v) })
+		}
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches
+}
+
 // SGStateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
 // a security group.
 func SGStateRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
@@ -832,6 +975,14 @@ func waitForSgToExist(conn *ec2.EC2, id string, timeout time.Duration) (interfac
 // If no match is found, we'll write the remote rule to state and let the graph
 // sort things out
 func matchRules(rType string, local []interface{}, remote []map[string]interface{}) []map[string]interface{} {
+	saves, unmatched := matchRulesPartitioned(rType, local, remote)
+	return append(saves, unmatched...)
+}
+
+// matchRulesPartitioned behaves like matchRules, but returns the remote rules
+// that have no local counterpart separately from the matched ones, so that
+// callers can decide whether those unknown rules are written to state.
+func matchRulesPartitioned(rType string, local []interface{}, remote []map[string]interface{}) ([]map[string]interface{}, []map[string]interface{}) {
 	// For each local ip or security_group, we need to match against the remote
 	// ruleSet until all ips or security_groups are found
 
@@ -1113,6 +1264,7 @@ func matchRules(rType string, local []interface{}, remote []map[string]interface
 	// cidrs, and security groups. We'll add remote rules here that have not been
 	// matched locally, and let the graph sort things out. This will happen when
 	// rules are added externally to Terraform
+	var unmatched []map[string]interface{}
 	for _, r := range remote {
 		var lenCidr, lenIpv6Cidr, lenPrefixLists, lenSGs int
 		if rCidrs, ok := r["cidr_blocks"]; ok {
@@ -1136,11 +1288,11 @@ func matchRules(rType string, local []interface{}, remote []map[string]interface
 
 		if lenSGs+lenCidr+lenIpv6Cidr+lenPrefixLists > 0 {
 			log.Printf("[DEBUG] Found a remote Rule that wasn't empty: (%#v)", r)
-			saves = append(saves, r)
+			unmatched = append(unmatched, r)
 		}
 	}
 
-	return saves
+	return saves, unmatched
 }
 
 // Creates a unique hash for the type, ports, and protocol, used as a key in
@@ -28,6 +28,8 @@ func resourceAwsSecurityGroupRule() *schema.Resource {
 		SchemaVersion: 2,
 		MigrateState:  resourceAwsSecurityGroupRuleMigrateState,
 
+		CustomizeDiff: resourceAwsSecurityGroupRuleCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"type": {
 				Type:        schema.TypeString,
@@ -116,6 +118,16 @@ func resourceAwsSecurityGroupRule() *schema.Resource {
 	}
 }
 
+func resourceAwsSecurityGroupRuleCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	// The group ID is not known yet when the group is created in the same
+	// plan; the claim is then recorded when the diff is recomputed on apply.
+	if !diff.NewValueKnown("security_group_id") {
+		return nil
+	}
+
+	return claimSecurityGroupRuleStandalone(meta.(*AWSClient).resourceOwnership, diff.Get("security_group_id").(string))
+}
+
 func resourceAwsSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	sg_id := d.Get("security_group_id").(string)
@@ -344,6 +344,69 @@ func TestResourceAwsSecurityGroupIPPermGather(t *testing.T) {
 	}
 }
 
+func TestBatchIPPerms(t *testing.T) {
+	cidrs := func(n int) []*ec2.IpRange {
+		ranges := make([]*ec2.IpRange, n)
+		for i := range ranges {
+			ranges[i] = &ec2.IpRange{CidrIp: aws.String(fmt.Sprintf("10.%d.%d.0/24", i/256, i%256))}
+		}
+		return ranges
+	}
+
+	cases := []struct {
+		name    string
+		perms   []*ec2.IpPermission
+		batches []int // number of sources per batch
+		perms0  int   // number of permissions in the first batch
+	}{
+		{
+			name:    "empty",
+			perms:   nil,
+			batches: nil,
+		},
+		{
+			name: "merges permissions sharing protocol and ports",
+			perms: []*ec2.IpPermission{
+				{IpProtocol: aws.String("tcp"), FromPort: aws.Int64(80), ToPort: aws.Int64(80), IpRanges: cidrs(2)},
+				{IpProtocol: aws.String("6"), FromPort: aws.Int64(80), ToPort: aws.Int64(80), UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-11111")}}},
+				{IpProtocol: aws.String("tcp"), FromPort: aws.Int64(443), ToPort: aws.Int64(443), IpRanges: cidrs(1)},
+			},
+			batches: []int{4},
+			perms0:  2,
+		},
+		{
+			name: "splits large permissions",
+			perms: []*ec2.IpPermission{
+				{IpProtocol: aws.String("tcp"), FromPort: aws.Int64(22), ToPort: aws.Int64(22), IpRanges: cidrs(250)},
+				{IpProtocol: aws.String("-1"), FromPort: aws.Int64(0), ToPort: aws.Int64(0), Ipv6Ranges: []*ec2.Ipv6Range{{CidrIpv6: aws.String("::/0")}}},
+			},
+			batches: []int{securityGroupRuleBatchSize, securityGroupRuleBatchSize, 51},
+			perms0:  1,
+		},
+	}
+
+	for _, tc := range cases {
+		batches := batchIPPerms(tc.perms)
+		if len(batches) != len(tc.batches) {
+			t.Fatalf("%s: expected %d batches, got %d", tc.name, len(tc.batches), len(batches))
+		}
+
+		for i, batch := range batches {
+			sources := 0
+			for _, perm := range batch {
+				sources += len(perm.IpRanges) + len(perm.Ipv6Ranges) + len(perm.PrefixListIds) + len(perm.UserIdGroupPairs)
+			}
+			if sources != tc.batches[i] {
+				t.Fatalf("%s: expected %d sources in batch %d, got %d", tc.name, tc.batches[i], i, sources)
+			}
+		}
+
+		if len(batches) > 0 && len(batches[0]) != tc.perms0 {
+			t.Fatalf("%s: expected %d permissions in first batch, got %d", tc.name, tc.perms0, len(batches[0]))
+		}
+	}
+}
+
 func TestAccAWSSecurityGroup_basic(t *testing.T) {
 	var group ec2.SecurityGroup
 
@@ -379,6 +442,74 @@ func TestAccAWSSecurityGroup_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSSecurityGroup_ruleManagementModeAdditive(t *testing.T) {
+	var group ec2.SecurityGroup
+	sgName := fmt.Sprintf("tf-acc-security-group-%s", acctest.RandString(7))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityGroupConfigRuleManagementMode(sgName, "additive"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupExists("aws_security_group.test", &group),
+					resource.TestCheckResourceAttr("aws_security_group.test", "rule_management_mode", "additive"),
+					// Only the inline rule is tracked by the group, the standalone
+					// rule is left alone.
+					resource.TestCheckResourceAttr("aws_security_group.test", "ingress.#", "1"),
+					resource.TestCheckResourceAttr("aws_security_group_rule.test", "from_port", "443"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSecurityGroup_ruleManagementModeExclusiveConflict(t *testing.T) {
+	sgName := fmt.Sprintf("tf-acc-security-group-%s", acctest.RandString(7))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSSecurityGroupConfigRuleManagementMode(sgName, "exclusive"),
+				ExpectError: regexp.MustCompile("manages its rules exclusively"),
+			},
+		},
+	})
+}
+
+func TestSecurityGroupRuleOwnership(t *testing.T) {
+	o := newResourceOwnership()
+
+	if err := claimSecurityGroupRulesExclusive(o, "sg-1"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := claimSecurityGroupRuleStandalone(o, "sg-2"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The conflict is reported whichever side is planned last.
+	err := claimSecurityGroupRuleStandalone(o, "sg-1")
+	if err == nil {
+		t.Fatal("expected conflict between exclusive group sg-1 and aws_security_group_rule")
+	}
+	if !regexp.MustCompile(`sg-1\) manages its rules exclusively`).MatchString(err.Error()) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = claimSecurityGroupRulesExclusive(o, "sg-2")
+	if err == nil {
+		t.Fatal("expected conflict between aws_security_group_rule and exclusive group sg-2")
+	}
+	if !regexp.MustCompile(`sg-2\) manages its rules exclusively`).MatchString(err.Error()) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
 func TestAccAWSSecurityGroup_ruleGathering(t *testing.T) {
 	var group ec2.SecurityGroup
 	sgName := fmt.Sprintf("tf-acc-security-group-%s", acctest.RandString(7))
@@ -3012,3 +3143,37 @@ resource "aws_security_group" "test" {
 }
 `, sgName)
 }
+
+func testAccAWSSecurityGroupConfigRuleManagementMode(sgName, mode string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-security-group-rule-management-mode"
+  }
+}
+
+resource "aws_security_group" "test" {
+  name                 = %q
+  vpc_id               = "${aws_vpc.test.id}"
+  rule_management_mode = %q
+
+  ingress {
+    protocol    = "tcp"
+    from_port   = 80
+    to_port     = 80
+    cidr_blocks = ["10.0.0.0/8"]
+  }
+}
+
+resource "aws_security_group_rule" "test" {
+  type              = "ingress"
+  protocol          = "tcp"
+  from_port         = 443
+  to_port           = 443
+  cidr_blocks       = ["10.0.0.0/8"]
+  security_group_id = "${aws_security_group.test.id}"
+}
+`, sgName, mode)
+}
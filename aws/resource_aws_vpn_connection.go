@@ -26,12 +26,33 @@ type XmlVpnConnectionConfig struct {
 }
 
 type XmlIpsecTunnel struct {
-	OutsideAddress   string `xml:"vpn_gateway>tunnel_outside_address>ip_address"`
-	BGPASN           string `xml:"vpn_gateway>bgp>asn"`
-	BGPHoldTime      int    `xml:"vpn_gateway>bgp>hold_time"`
-	PreSharedKey     string `xml:"ike>pre_shared_key"`
-	CgwInsideAddress string `xml:"customer_gateway>tunnel_inside_address>ip_address"`
-	VgwInsideAddress string `xml:"vpn_gateway>tunnel_inside_address>ip_address"`
+	OutsideAddress                     string `xml:"vpn_gateway>tunnel_outside_address>ip_address"`
+	BGPASN                             string `xml:"vpn_gateway>bgp>asn"`
+	BGPHoldTime                        int    `xml:"vpn_gateway>bgp>hold_time"`
+	PreSharedKey                       string `xml:"ike>pre_shared_key"`
+	CgwInsideAddress                   string `xml:"customer_gateway>tunnel_inside_address>ip_address"`
+	VgwInsideAddress                   string `xml:"vpn_gateway>tunnel_inside_address>ip_address"`
+	CgwOutsideAddress                  string `xml:"customer_gateway>tunnel_outside_address>ip_address"`
+	CgwBGPASN                          string `xml:"customer_gateway>bgp>asn"`
+	CgwBGPHoldTime                     int    `xml:"customer_gateway>bgp>hold_time"`
+	InsideNetworkMask                  string `xml:"vpn_gateway>tunnel_inside_address>network_mask"`
+	InsideNetworkCidr                  int    `xml:"vpn_gateway>tunnel_inside_address>network_cidr"`
+	IkeAuthenticationProtocol          string `xml:"ike>authentication_protocol"`
+	IkeEncryptionProtocol              string `xml:"ike>encryption_protocol"`
+	IkeLifetime                        int    `xml:"ike>lifetime"`
+	IkePerfectForwardSecrecy           string `xml:"ike>perfect_forward_secrecy"`
+	IkeMode                            string `xml:"ike>mode"`
+	IpsecProtocol                      string `xml:"ipsec>protocol"`
+	IpsecAuthenticationProtocol        string `xml:"ipsec>authentication_protocol"`
+	IpsecEncryptionProtocol            string `xml:"ipsec>encryption_protocol"`
+	IpsecLifetime                      int    `xml:"ipsec>lifetime"`
+	IpsecPerfectForwardSecrecy         string `xml:"ipsec>perfect_forward_secrecy"`
+	IpsecMode                          string `xml:"ipsec>mode"`
+	IpsecClearDfBit                    bool   `xml:"ipsec>clear_df_bit"`
+	IpsecFragmentationBeforeEncryption bool   `xml:"ipsec>fragmentation_before_encryption"`
+	IpsecTcpMssAdjustment              int    `xml:"ipsec>tcp_mss_adjustment"`
+	IpsecDeadPeerDetectionDelay        int    `xml:"ipsec>dead_peer_detection>delay"`
+	IpsecDeadPeerDetectionRetries      int    `xml:"ipsec>dead_peer_detection>retries"`
 }
 
 type TunnelInfo struct {
@@ -182,6 +203,8 @@ func resourceAwsVpnConnection() *schema.Resource {
 				Computed: true,
 			},
 
+			"tunnels": vpnConnectionTunnelsSchema(),
+
 			"routes": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -428,6 +451,12 @@ func resourceAwsVpnConnectionRead(d *schema.ResourceData, meta interface{}) erro
 			d.Set("tunnel2_bgp_asn", tunnelInfo.Tunnel2BGPASN)
 			d.Set("tunnel2_bgp_holdtime", tunnelInfo.Tunnel2BGPHoldTime)
 		}
+
+		if vpnConfig, err := xmlConfigToVpnConnectionConfig(*vpnConnection.CustomerGatewayConfiguration); err != nil {
+			log.Printf("[ERR] Error unmarshaling XML configuration for (%s): %s", d.Id(), err)
+		} else if err := d.Set("tunnels", flattenVpnConnectionTunnels(vpnConfig)); err != nil {
+			return err
+		}
 	}
 
 	if err := d.Set("vgw_telemetry", telemetryToMapList(vpnConnection.VgwTelemetry)); err != nil {
@@ -529,7 +558,201 @@ func telemetryToMapList(telemetry []*ec2.VgwTelemetry) []map[string]interface{}
 	return result
 }
 
-func xmlConfigToTunnelInfo(xmlConfig string) (*TunnelInfo, error) {
+// vpnConnectionTunnelsSchema returns the computed schema of the per-tunnel
+// settings parsed out of the customer gateway configuration.
+func vpnConnectionTunnelsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"outside_address": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"cgw_outside_address": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"inside_cidr": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"inside_network_mask": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"cgw_inside_address": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"vgw_inside_address": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"preshared_key": {
+					Type:      schema.TypeString,
+					Computed:  true,
+					Sensitive: true,
+				},
+				"bgp_asn": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"bgp_holdtime": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"cgw_bgp_asn": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"cgw_bgp_holdtime": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"ike": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"authentication_protocol": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"encryption_protocol": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"lifetime": {
+								Type:     schema.TypeInt,
+								Computed: true,
+							},
+							"perfect_forward_secrecy": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"mode": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"ipsec": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"protocol": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"authentication_protocol": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"encryption_protocol": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"lifetime": {
+								Type:     schema.TypeInt,
+								Computed: true,
+							},
+							"perfect_forward_secrecy": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"mode": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"clear_df_bit": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+							"fragmentation_before_encryption": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+							"tcp_mss_adjustment": {
+								Type:     schema.TypeInt,
+								Computed: true,
+							},
+							"dead_peer_detection_delay": {
+								Type:     schema.TypeInt,
+								Computed: true,
+							},
+							"dead_peer_detection_retries": {
+								Type:     schema.TypeInt,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// flattenVpnConnectionTunnels turns the parsed tunnel configuration into a
+// list of maps, in the same order as the tunnel1_* and tunnel2_* attributes.
+func flattenVpnConnectionTunnels(vpnConfig *XmlVpnConnectionConfig) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(vpnConfig.Tunnels))
+	for _, t := range vpnConfig.Tunnels {
+		tunnel := map[string]interface{}{
+			"outside_address":     t.OutsideAddress,
+			"cgw_outside_address": t.CgwOutsideAddress,
+			"inside_network_mask": t.InsideNetworkMask,
+			"cgw_inside_address":  t.CgwInsideAddress,
+			"vgw_inside_address":  t.VgwInsideAddress,
+			"preshared_key":       t.PreSharedKey,
+			"bgp_asn":             t.BGPASN,
+			"bgp_holdtime":        t.BGPHoldTime,
+			"cgw_bgp_asn":         t.CgwBGPASN,
+			"cgw_bgp_holdtime":    t.CgwBGPHoldTime,
+			"ike": []interface{}{
+				map[string]interface{}{
+					"authentication_protocol": t.IkeAuthenticationProtocol,
+					"encryption_protocol":     t.IkeEncryptionProtocol,
+					"lifetime":                t.IkeLifetime,
+					"perfect_forward_secrecy": t.IkePerfectForwardSecrecy,
+					"mode":                    t.IkeMode,
+				},
+			},
+			"ipsec": []interface{}{
+				map[string]interface{}{
+					"protocol":                        t.IpsecProtocol,
+					"authentication_protocol":         t.IpsecAuthenticationProtocol,
+					"encryption_protocol":             t.IpsecEncryptionProtocol,
+					"lifetime":                        t.IpsecLifetime,
+					"perfect_forward_secrecy":         t.IpsecPerfectForwardSecrecy,
+					"mode":                            t.IpsecMode,
+					"clear_df_bit":                    t.IpsecClearDfBit,
+					"fragmentation_before_encryption": t.IpsecFragmentationBeforeEncryption,
+					"tcp_mss_adjustment":              t.IpsecTcpMssAdjustment,
+					"dead_peer_detection_delay":       t.IpsecDeadPeerDetectionDelay,
+					"dead_peer_detection_retries":     t.IpsecDeadPeerDetectionRetries,
+				},
+			},
+		}
+
+		// The XML only carries the address and prefix length of the
+		// tunnel inside network; rebuild the CIDR block from them.
+		if t.InsideNetworkCidr > 0 {
+			if _, ipnet, err := net.ParseCIDR(fmt.Sprintf("%s/%d", t.VgwInsideAddress, t.InsideNetworkCidr)); err == nil {
+				tunnel["inside_cidr"] = ipnet.String()
+			}
+		}
+
+		result = append(result, tunnel)
+	}
+
+	return result
+}
+
+func xmlConfigToVpnConnectionConfig(xmlConfig string) (*XmlVpnConnectionConfig, error) {
 	var vpnConfig XmlVpnConnectionConfig
 	if err := xml.Unmarshal([]byte(xmlConfig), &vpnConfig); err != nil {
 		return nil, errwrap.Wrapf("Error Unmarshalling XML: {{err}}", err)
@@ -538,6 +761,19 @@ func xmlConfigToTunnelInfo(xmlConfig string) (*TunnelInfo, error) {
 	// don't expect consistent ordering from the XML
 	sort.Sort(vpnConfig)
 
+	return &vpnConfig, nil
+}
+
+func xmlConfigToTunnelInfo(xmlConfig string) (*TunnelInfo, error) {
+	vpnConfig, err := xmlConfigToVpnConnectionConfig(xmlConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(vpnConfig.Tunnels) < 2 {
+		return nil, fmt.Errorf("Expected 2 tunnels in XML, got %d", len(vpnConfig.Tunnels))
+	}
+
 	tunnelInfo := TunnelInfo{
 		Tunnel1Address:          vpnConfig.Tunnels[0].OutsideAddress,
 		Tunnel1PreSharedKey:     vpnConfig.Tunnels[0].PreSharedKey,
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"reflect"
 	"regexp"
 	"testing"
 	"time"
@@ -36,6 +37,14 @@ func TestAccAWSVpnConnection_basic(t *testing.T) {
 						"aws_vpn_connection.foo",
 						&vpn,
 					),
+					resource.TestCheckResourceAttr("aws_vpn_connection.foo", "tunnels.#", "2"),
+					resource.TestCheckResourceAttrPair("aws_vpn_connection.foo", "tunnels.0.outside_address", "aws_vpn_connection.foo", "tunnel1_address"),
+					resource.TestCheckResourceAttrPair("aws_vpn_connection.foo", "tunnels.1.outside_address", "aws_vpn_connection.foo", "tunnel2_address"),
+					resource.TestCheckResourceAttr("aws_vpn_connection.foo", "tunnels.0.cgw_outside_address", "178.0.0.1"),
+					resource.TestCheckResourceAttr("aws_vpn_connection.foo", "tunnels.0.ike.#", "1"),
+					resource.TestCheckResourceAttrSet("aws_vpn_connection.foo", "tunnels.0.ike.0.encryption_protocol"),
+					resource.TestCheckResourceAttr("aws_vpn_connection.foo", "tunnels.0.ipsec.#", "1"),
+					resource.TestCheckResourceAttrSet("aws_vpn_connection.foo", "tunnels.0.ipsec.0.dead_peer_detection_delay"),
 				),
 			},
 			{
@@ -369,6 +378,78 @@ func TestAWSVpnConnection_xmlconfig(t *testing.T) {
 	}
 }
 
+func TestAWSVpnConnection_flattenTunnels(t *testing.T) {
+	vpnConfig, err := xmlConfigToVpnConnectionConfig(testAccAwsVpnTunnelInfoXML)
+	if err != nil {
+		t.Fatalf("Error unmarshalling XML: %s", err)
+	}
+
+	tunnels := flattenVpnConnectionTunnels(vpnConfig)
+	if len(tunnels) != 2 {
+		t.Fatalf("Expected 2 tunnels, got %d", len(tunnels))
+	}
+
+	first := tunnels[0]
+	if first["outside_address"] != "FIRST_ADDRESS" {
+		t.Fatalf("First address from tunnel XML was incorrect: %v", first["outside_address"])
+	}
+	if first["cgw_outside_address"] != "123.123.123.123" {
+		t.Fatalf("First Customer Gateway address from tunnel XML was incorrect: %v", first["cgw_outside_address"])
+	}
+	if first["inside_network_mask"] != "255.255.255.252" {
+		t.Fatalf("First inside network mask from tunnel XML was incorrect: %v", first["inside_network_mask"])
+	}
+	if _, ok := first["inside_cidr"]; ok {
+		t.Fatalf("Expected no inside CIDR for an invalid inside address, got %v", first["inside_cidr"])
+	}
+	if first["cgw_bgp_asn"] != "65000" || first["cgw_bgp_holdtime"] != 30 {
+		t.Fatalf("First Customer Gateway bgp settings from tunnel XML were incorrect: %v/%v",
+			first["cgw_bgp_asn"], first["cgw_bgp_holdtime"])
+	}
+	if tunnels[1]["preshared_key"] != "SECOND_KEY" {
+		t.Fatalf("Second key from tunnel XML was incorrect: %v", tunnels[1]["preshared_key"])
+	}
+
+	ike := first["ike"].([]interface{})[0].(map[string]interface{})
+	expectedIke := map[string]interface{}{
+		"authentication_protocol": "sha1",
+		"encryption_protocol":     "aes-128-cbc",
+		"lifetime":                28800,
+		"perfect_forward_secrecy": "group2",
+		"mode":                    "main",
+	}
+	if !reflect.DeepEqual(ike, expectedIke) {
+		t.Fatalf("IKE settings from tunnel XML were incorrect.\nExpected: %#v\nGot: %#v", expectedIke, ike)
+	}
+
+	ipsec := first["ipsec"].([]interface{})[0].(map[string]interface{})
+	expectedIpsec := map[string]interface{}{
+		"protocol":                        "esp",
+		"authentication_protocol":         "hmac-sha1-96",
+		"encryption_protocol":             "aes-128-cbc",
+		"lifetime":                        3600,
+		"perfect_forward_secrecy":         "group2",
+		"mode":                            "tunnel",
+		"clear_df_bit":                    true,
+		"fragmentation_before_encryption": true,
+		"tcp_mss_adjustment":              1379,
+		"dead_peer_detection_delay":       10,
+		"dead_peer_detection_retries":     3,
+	}
+	if !reflect.DeepEqual(ipsec, expectedIpsec) {
+		t.Fatalf("IPsec settings from tunnel XML were incorrect.\nExpected: %#v\nGot: %#v", expectedIpsec, ipsec)
+	}
+
+	tunnels = flattenVpnConnectionTunnels(&XmlVpnConnectionConfig{
+		Tunnels: []XmlIpsecTunnel{
+			{VgwInsideAddress: "169.254.12.1", InsideNetworkCidr: 30},
+		},
+	})
+	if tunnels[0]["inside_cidr"] != "169.254.12.0/30" {
+		t.Fatalf("Inside CIDR was incorrect: %v", tunnels[0]["inside_cidr"])
+	}
+}
+
 func testAccAwsVpnConnectionConfig(rBgpAsn int) string {
 	return fmt.Sprintf(`
 resource "aws_vpn_gateway" "vpn_gateway" {
@@ -496,6 +577,10 @@ const testAccAwsVpnTunnelInfoXML = `
         <network_mask>255.255.255.252</network_mask>
         <network_cidr>30</network_cidr>
       </tunnel_inside_address>
+      <bgp>
+        <asn>65000</asn>
+        <hold_time>30</hold_time>
+      </bgp>
     </customer_gateway>
     <vpn_gateway>
       <tunnel_outside_address>
@@ -512,8 +597,28 @@ const testAccAwsVpnTunnelInfoXML = `
       </bgp>
     </vpn_gateway>
     <ike>
+      <authentication_protocol>sha1</authentication_protocol>
+      <encryption_protocol>aes-128-cbc</encryption_protocol>
+      <lifetime>28800</lifetime>
+      <perfect_forward_secrecy>group2</perfect_forward_secrecy>
+      <mode>main</mode>
       <pre_shared_key>SECOND_KEY</pre_shared_key>
     </ike>
+    <ipsec>
+      <protocol>esp</protocol>
+      <authentication_protocol>hmac-sha1-96</authentication_protocol>
+      <encryption_protocol>aes-128-cbc</encryption_protocol>
+      <lifetime>3600</lifetime>
+      <perfect_forward_secrecy>group2</perfect_forward_secrecy>
+      <mode>tunnel</mode>
+      <clear_df_bit>true</clear_df_bit>
+      <fragmentation_before_encryption>true</fragmentation_before_encryption>
+      <tcp_mss_adjustment>1379</tcp_mss_adjustment>
+      <dead_peer_detection>
+        <delay>10</delay>
+        <retries>3</retries>
+      </dead_peer_detection>
+    </ipsec>
   </ipsec_tunnel>
   <ipsec_tunnel>
     <customer_gateway>
@@ -525,6 +630,10 @@ const testAccAwsVpnTunnelInfoXML = `
         <network_mask>255.255.255.252</network_mask>
         <network_cidr>30</network_cidr>
       </tunnel_inside_address>
+      <bgp>
+        <asn>65000</asn>
+        <hold_time>30</hold_time>
+      </bgp>
     </customer_gateway>
     <vpn_gateway>
       <tunnel_outside_address>
@@ -541,8 +650,28 @@ const testAccAwsVpnTunnelInfoXML = `
       </bgp>
     </vpn_gateway>
     <ike>
+      <authentication_protocol>sha1</authentication_protocol>
+      <encryption_protocol>aes-128-cbc</encryption_protocol>
+      <lifetime>28800</lifetime>
+      <perfect_forward_secrecy>group2</perfect_forward_secrecy>
+      <mode>main</mode>
       <pre_shared_key>FIRST_KEY</pre_shared_key>
     </ike>
+    <ipsec>
+      <protocol>esp</protocol>
+      <authentication_protocol>hmac-sha1-96</authentication_protocol>
+      <encryption_protocol>aes-128-cbc</encryption_protocol>
+      <lifetime>3600</lifetime>
+      <perfect_forward_secrecy>group2</perfect_forward_secrecy>
+      <mode>tunnel</mode>
+      <clear_df_bit>true</clear_df_bit>
+      <fragmentation_before_encryption>true</fragmentation_before_encryption>
+      <tcp_mss_adjustment>1379</tcp_mss_adjustment>
+      <dead_peer_detection>
+        <delay>10</delay>
+        <retries>3</retries>
+      </dead_peer_detection>
+    </ipsec>
   </ipsec_tunnel>
 </vpn_connection>
 `
@@ -0,0 +1,46 @@
+package aws
+
+import (
+	"sync"
+)
+
+// resourceOwnership records, for the lifetime of the provider, which remote
+// objects are managed authoritatively by an argument of one resource and
+// which are targeted by standalone resources that would fight with it. Keys
+// are namespaced by the caller. Whichever side is planned last sees the other
+// claim and reports the conflict.
+type resourceOwnership struct {
+	sync.Mutex
+
+	authoritative map[string]string
+	standalone    map[string]string
+}
+
+func newResourceOwnership() *resourceOwnership {
+	return &resourceOwnership{
+		authoritative: make(map[string]string),
+		standalone:    make(map[string]string),
+	}
+}
+
+// claimAuthoritative records that key is managed authoritatively by owner and
+// returns the standalone resource already claiming key, if any.
+func (o *resourceOwnership) claimAuthoritative(key, owner string) (string, bool) {
+	o.Lock()
+	defer o.Unlock()
+
+	o.authoritative[key] = owner
+	standalone, ok := o.standalone[key]
+	return standalone, ok
+}
+
+// claimStandalone records that key is targeted by the standalone resource
+// owner and returns the authoritative owner already claiming key, if any.
+func (o *resourceOwnership) claimStandalone(key, owner string) (string, bool) {
+	o.Lock()
+	defer o.Unlock()
+
+	o.standalone[key] = owner
+	authoritative, ok := o.authoritative[key]
+	return authoritative, ok
+}
@@ -0,0 +1,24 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestResourceOwnership(t *testing.T) {
+	o := newResourceOwnership()
+
+	if _, ok := o.claimAuthoritative("a", "inline"); ok {
+		t.Fatal("unexpected conflict for a")
+	}
+	if _, ok := o.claimStandalone("b", "aws_standalone"); ok {
+		t.Fatal("unexpected conflict for b")
+	}
+
+	// The conflict is reported whichever side is planned last.
+	if owner, ok := o.claimStandalone("a", "aws_standalone"); !ok || owner != "inline" {
+		t.Fatalf("expected conflict with inline for a, got %q (%t)", owner, ok)
+	}
+	if owner, ok := o.claimAuthoritative("b", "inline"); !ok || owner != "aws_standalone" {
+		t.Fatalf("expected conflict with aws_standalone for b, got %q (%t)", owner, ok)
+	}
+}
@@ -3795,6 +3795,46 @@ func diffDynamoDbGSI(oldGsi, newGsi []interface{}) (ops []*dynamodb.GlobalSecond
 	return
 }
 
+// flattenDynamoDbGlobalSecondaryIndexes returns the indexes in the form of
+// the global_secondary_index configuration, so they can be passed to
+// diffDynamoDbGSI.
+func flattenDynamoDbGlobalSecondaryIndexes(gsis []*dynamodb.GlobalSecondaryIndexDescription) []interface{} {
+	result := make([]interface{}, 0, len(gsis))
+	for _, gsi := range gsis {
+		m := map[string]interface{}{
+			"name":               aws.StringValue(gsi.IndexName),
+			"write_capacity":     0,
+			"read_capacity":      0,
+			"hash_key":           "",
+			"range_key":          "",
+			"projection_type":    "",
+			"non_key_attributes": []interface{}{},
+		}
+		if gsi.ProvisionedThroughput != nil {
+			m["write_capacity"] = int(aws.Int64Value(gsi.ProvisionedThroughput.WriteCapacityUnits))
+			m["read_capacity"] = int(aws.Int64Value(gsi.ProvisionedThroughput.ReadCapacityUnits))
+		}
+		for _, attribute := range gsi.KeySchema {
+			if aws.StringValue(attribute.KeyType) == dynamodb.KeyTypeHash {
+				m["hash_key"] = aws.StringValue(attribute.AttributeName)
+			}
+			if aws.StringValue(attribute.KeyType) == dynamodb.KeyTypeRange {
+				m["range_key"] = aws.StringValue(attribute.AttributeName)
+			}
+		}
+		if gsi.Projection != nil {
+			m["projection_type"] = aws.StringValue(gsi.Projection.ProjectionType)
+			nonKeyAttributes := make([]interface{}, 0, len(gsi.Projection.NonKeyAttributes))
+			for _, v := range gsi.Projection.NonKeyAttributes {
+				nonKeyAttributes = append(nonKeyAttributes, aws.StringValue(v))
+			}
+			m["non_key_attributes"] = nonKeyAttributes
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
 func stripCapacityAttributes(in map[string]interface{}) (map[string]interface{}, error) {
 	mapCopy, err := copystructure.Copy(in)
 	if err != nil {
@@ -3924,6 +3964,12 @@ func flattenAwsDynamoDbTableResource(d *schema.ResourceData, table *dynamodb.Tab
 		}
 		gsi["non_key_attributes"] = nonKeyAttrs
 
+		for _, key := range []string{"read_capacity", "write_capacity"} {
+			if capacity, ok := dynamoDbAutoscaledIndexCapacity(d, *gsiObject.IndexName, key); ok {
+				gsi[key] = capacity
+			}
+		}
+
 		gsiList = append(gsiList, gsi)
 	}
 
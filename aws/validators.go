@@ -586,6 +586,39 @@ func validateIAMPolicyJson(v interface{}, k string) (ws []string, errors []error
 	return
 }
 
+// validateIAMIdentityPolicyJson validates a policy attached to a user, group
+// or role, see validateIAMPolicyDocument.
+func validateIAMIdentityPolicyJson(v interface{}, k string) (ws []string, errors []error) {
+	return validateIAMPolicyDocumentJson(v, k, iamPolicyDocumentIdentity, validateIAMPolicyJson)
+}
+
+// validateIAMTrustPolicyJson validates a role's assume role policy, see
+// validateIAMPolicyDocument.
+func validateIAMTrustPolicyJson(v interface{}, k string) (ws []string, errors []error) {
+	return validateIAMPolicyDocumentJson(v, k, iamPolicyDocumentTrust, validateJsonString)
+}
+
+// validateIAMResourcePolicyJson validates a policy that is not known to be
+// identity-based, e.g. a resource policy, see validateIAMPolicyDocument.
+func validateIAMResourcePolicyJson(v interface{}, k string) (ws []string, errors []error) {
+	return validateIAMPolicyDocumentJson(v, k, iamPolicyDocumentAny, validateIAMPolicyJson)
+}
+
+func validateIAMPolicyDocumentJson(v interface{}, k string, docType iamPolicyDocumentType, validateJson func(interface{}, string) ([]string, []error)) (ws []string, errors []error) {
+	if ws, errors = validateJson(v, k); len(errors) > 0 {
+		return
+	}
+
+	docWarnings, docErrors := validateIAMPolicyDocument(v.(string), docType, "")
+	for _, w := range docWarnings {
+		ws = append(ws, fmt.Sprintf("%q: %s", k, w))
+	}
+	for _, err := range docErrors {
+		errors = append(errors, fmt.Errorf("%q: %s", k, err))
+	}
+	return
+}
+
 func validateCloudFormationTemplate(v interface{}, k string) (ws []string, errors []error) {
 	if looksLikeJsonString(v) {
 		if _, err := structure.NormalizeJsonString(v); err != nil {
@@ -1784,3 +1817,29 @@ func validateLaunchTemplateId(v interface{}, k string) (ws []string, errors []er
 	}
 	return
 }
+
+func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(map[string]interface{})
+
+	for key := range value {
+		if key != strings.ToLower(key) {
+			errors = append(errors, fmt.Errorf(
+				"Metadata must be lowercase only. Offending key: %q", key))
+		}
+	}
+	return
+}
+
+func validatePositiveDuration(v interface{}, k string) (ws []string, errors []error) {
+	duration, err := time.ParseDuration(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be parsed as a duration: %s", k, err))
+		return
+	}
+	if duration <= 0 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be greater than zero", k))
+	}
+	return
+}
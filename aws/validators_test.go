@@ -755,6 +755,59 @@ func TestValidateIAMPolicyJsonString(t *testing.T) {
 	}
 }
 
+func TestValidateIAMIdentityPolicyJson(t *testing.T) {
+	validCases := []string{
+		`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		`{"Statement":{"Effect":"Deny","NotAction":"iam:*","NotResource":"arn:aws:iam::123456789012:user/*"}}`,
+	}
+	for _, v := range validCases {
+		if _, errors := validateIAMIdentityPolicyJson(v, "policy"); len(errors) != 0 {
+			t.Fatalf("%q should be a valid identity policy: %q", v, errors)
+		}
+	}
+
+	invalidCases := []string{
+		`{0:"1"}`,
+		`{}`,
+		`{"Statement":[{"Effect":"Allow","Action":"s3:GetObject"}]}`,
+		`{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Principal":"*"}]}`,
+		`{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEqual":{"aws:username":"a"}}}]}`,
+	}
+	for _, v := range invalidCases {
+		if _, errors := validateIAMIdentityPolicyJson(v, "policy"); len(errors) == 0 {
+			t.Fatalf("%q should be an invalid identity policy", v)
+		}
+	}
+
+	ws, _ := validateIAMIdentityPolicyJson(`{"Statement":[{"Effect":"Allow","Action":"s3:GetObjekt","Resource":"*"}]}`, "policy")
+	if len(ws) != 1 || !strings.Contains(ws[0], `unknown s3 action "s3:GetObjekt"`) {
+		t.Fatalf("expected an unknown action warning, got %q", ws)
+	}
+}
+
+func TestValidateIAMTrustPolicyJson(t *testing.T) {
+	validCases := []string{
+		`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"Service":["ec2.amazonaws.com"]},"Sid":""}]}`,
+		`{"Statement":[{"Effect":"Allow","Action":"sts:AssumeRoleWithWebIdentity","Principal":{"Federated":"cognito-identity.amazonaws.com"},"Condition":{"StringEquals":{"cognito-identity.amazonaws.com:aud":"x"}}}]}`,
+	}
+	for _, v := range validCases {
+		if _, errors := validateIAMTrustPolicyJson(v, "assume_role_policy"); len(errors) != 0 {
+			t.Fatalf("%q should be a valid trust policy: %q", v, errors)
+		}
+	}
+
+	invalidCases := []string{
+		`{"Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"Service":"ec2.amazonaws.com",}}]}`,
+		`{"Statement":[{"Effect":"Allow","Action":"sts:AssumeRole"}]}`,
+		`{"Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":"*"},"Resource":"*"}]}`,
+	}
+	for _, v := range invalidCases {
+		if _, errors := validateIAMTrustPolicyJson(v, "assume_role_policy"); len(errors) == 0 {
+			t.Fatalf("%q should be an invalid trust policy", v)
+		}
+	}
+}
+
 func TestValidateCloudFormationTemplate(t *testing.T) {
 	type testCases struct {
 		Value    string
@@ -2586,3 +2639,54 @@ func TestValidateLaunchTemplateId(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateMetadataIsLowerCase(t *testing.T) {
+	validMetadata := []map[string]interface{}{
+		{"key1": "value1"},
+		{"key1": "value1", "key2": "VALUE2"},
+	}
+	for _, v := range validMetadata {
+		_, errors := validateMetadataIsLowerCase(v, "metadata")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be valid metadata: %q", v, errors)
+		}
+	}
+
+	invalidMetadata := []map[string]interface{}{
+		{"Key1": "value1"},
+		{"key1": "value1", "KEY2": "value2"},
+	}
+	for _, v := range invalidMetadata {
+		_, errors := validateMetadataIsLowerCase(v, "metadata")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be invalid metadata", v)
+		}
+	}
+}
+
+func TestValidatePositiveDuration(t *testing.T) {
+	validDurations := []string{
+		"1s",
+		"90s",
+		"5m",
+	}
+	for _, v := range validDurations {
+		_, errors := validatePositiveDuration(v, "iam_propagation_timeout")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid duration: %q", v, errors)
+		}
+	}
+
+	invalidDurations := []string{
+		"0s",
+		"0",
+		"-1m",
+		"2 minutes",
+	}
+	for _, v := range invalidDurations {
+		_, errors := validatePositiveDuration(v, "iam_propagation_timeout")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid duration", v)
+		}
+	}
+}